@@ -81,6 +81,7 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	appparams "github.com/enqack/nameservice/app/params"
 	"github.com/enqack/nameservice/x/nameservice"
+	nameserviceclient "github.com/enqack/nameservice/x/nameservice/client"
 	nameservicekeeper "github.com/enqack/nameservice/x/nameservice/keeper"
 	nameservicetypes "github.com/enqack/nameservice/x/nameservice/types"
 	tmjson "github.com/tendermint/tendermint/libs/json"
@@ -101,6 +102,7 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		distrclient.ProposalHandler,
 		upgradeclient.ProposalHandler,
 		upgradeclient.CancelProposalHandler,
+		nameserviceclient.SetTldProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
 
@@ -144,6 +146,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		nameservicetypes.ModuleName:    {authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -330,10 +333,11 @@ func New(
 	app.EvidenceKeeper = *evidenceKeeper
 
 	app.nameserviceKeeper = *nameservicekeeper.NewKeeper(
-		app.BankKeeper, appCodec,
+		app.BankKeeper, app.DistrKeeper, appCodec,
 		keys[nameservicetypes.StoreKey], keys[nameservicetypes.MemStoreKey],
 		app.GetSubspace(nameservicetypes.ModuleName),
 	)
+	govRouter.AddRoute(nameservicetypes.RouterKey, nameservice.NewTldProposalHandler(app.nameserviceKeeper))
 
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
@@ -384,7 +388,7 @@ func New(
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName,
 	)
 
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
+	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, nameservicetypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -400,13 +404,14 @@ func New(
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
-		crisistypes.ModuleName,
 		ibchost.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		nameservicetypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
+		// crisis runs last so that the invariants it asserts see the whole genesis state
+		crisistypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -429,6 +434,8 @@ func New(
 	)
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.registerUpgradeHandlers()
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -0,0 +1,22 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	nameservicekeeper "github.com/enqack/nameservice/x/nameservice/keeper"
+)
+
+// UpgradeNameservice is the upgrade migrating the nameservice module of a chain started
+// from any earlier release: typed params, the version 2 store layout, the default top
+// level domains and lowercase names, in that order
+const UpgradeNameservice = "nameservice-v2"
+
+// registerUpgradeHandlers sets the store migrations run by the upgrade module
+func (app *App) registerUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeNameservice, func(ctx sdk.Context, plan upgradetypes.Plan) {
+		if err := nameservicekeeper.NewMigrator(app.nameserviceKeeper).Migrate(ctx); err != nil {
+			panic(err)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/enqack/nameservice/x/nameservice/keeper"
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+// distrKeeper moves community pool funds to the distribution module account like the
+// distribution keeper does, without its bookkeeping
+type distrKeeper struct {
+	bankKeeper bankkeeper.Keeper
+}
+
+func (k distrKeeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return k.bankKeeper.SendCoins(ctx, sender, authtypes.NewModuleAddress(distrtypes.ModuleName), amount)
+}
+
+// NameserviceKeeper returns a nameservice keeper backed by an in-memory store, with
+// its default params set and at the current store version, the context to use it with and the key of its store
+func NameserviceKeeper(t testing.TB) (*keeper.Keeper, sdk.Context, sdk.StoreKey) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	authStoreKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+	bankStoreKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	for _, key := range []sdk.StoreKey{storeKey, authStoreKey, bankStoreKey, paramsStoreKey} {
+		stateStore.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	stateStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(paramsTStoreKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	legacyAmino := codec.NewLegacyAmino()
+
+	paramsKeeper := paramskeeper.NewKeeper(cdc, legacyAmino, paramsStoreKey, paramsTStoreKey)
+	accountKeeper := authkeeper.NewAccountKeeper(
+		cdc, authStoreKey, paramsKeeper.Subspace(authtypes.ModuleName), authtypes.ProtoBaseAccount,
+		map[string][]string{types.ModuleName: {authtypes.Burner}},
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		cdc, bankStoreKey, accountKeeper, paramsKeeper.Subspace(banktypes.ModuleName), map[string]bool{},
+	)
+
+	k := keeper.NewKeeper(
+		bankKeeper, distrKeeper{bankKeeper}, cdc, storeKey, memStoreKey, paramsKeeper.Subspace(types.ModuleName),
+	)
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	// BurnCoins takes burned fees off the supply, which has to exist for it
+	bankKeeper.SetSupply(ctx, banktypes.NewSupply(sdk.NewCoins()))
+	k.SetParams(ctx, types.DefaultParams())
+	k.SetStoreVersion(ctx, types.ConsensusVersion)
+
+	return k, ctx, storeKey
+}
+
+// FundAccount adds coins to the balance of addr and to the supply
+func FundAccount(t testing.TB, k *keeper.Keeper, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	balances := k.CoinKeeper.GetAllBalances(ctx, addr).Add(coins...)
+	require.NoError(t, k.CoinKeeper.SetBalances(ctx, addr, balances))
+
+	supply := k.CoinKeeper.GetSupply(ctx)
+	supply.Inflate(coins)
+	k.CoinKeeper.SetSupply(ctx, supply)
+}
@@ -0,0 +1,11 @@
+package nameservice
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/enqack/nameservice/x/nameservice/keeper"
+)
+
+// EndBlocker releases the names whose expiry grace period has elapsed
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ReleaseExpiredWhois(ctx)
+}
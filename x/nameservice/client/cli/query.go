@@ -28,6 +28,15 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListWhois())
 	cmd.AddCommand(CmdShowWhois())
+	cmd.AddCommand(CmdResolve())
+	cmd.AddCommand(CmdRegistrationCost())
+	cmd.AddCommand(CmdNamesByAddress())
+	cmd.AddCommand(CmdNamesOwned())
+	cmd.AddCommand(CmdListWhoisTransfer())
+	cmd.AddCommand(CmdShowWhoisTransfer())
+	cmd.AddCommand(CmdListTlds())
+	cmd.AddCommand(CmdShowFeeTotals())
+	cmd.AddCommand(CmdQueryParams())
 
 	return cmd
 }
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowFeeTotals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-fee-totals",
+		Short: "shows the fees collected by the module",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFeeTotalsRequest{}
+
+			res, err := queryClient.FeeTotals(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdRegistrationCost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registration-cost [name]",
+		Short: "shows the price of registering a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRegistrationCostRequest{
+				Name: types.CanonicalName(args[0]),
+			}
+
+			res, err := queryClient.RegistrationCost(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdResolve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve [name]",
+		Short: "resolves a name to its target address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryResolveRequest{
+				Name: types.CanonicalName(args[0]),
+			}
+
+			res, err := queryClient.Resolve(context.Background(), params)
+			if err != nil {
+				if isNotFound(err) {
+					return fmt.Errorf("no such name: %s", args[0])
+				}
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
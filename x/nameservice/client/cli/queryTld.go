@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"github.com/spf13/cobra"
+)
+
+const FlagAll = "all"
+
+func CmdListTlds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-tlds",
+		Short: "list the top level domains open for registration",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			all, err := cmd.Flags().GetBool(FlagAll)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTldsRequest{
+				IncludeDisabled: all,
+			}
+
+			res, err := queryClient.Tlds(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAll, false, "include disabled top level domains")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -43,20 +44,26 @@ func CmdListWhois() *cobra.Command {
 
 func CmdShowWhois() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-whois [id]",
-		Short: "shows a whois",
+		Use:   "show-whois [name]",
+		Short: "shows the whois registered under name, or with the given id",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetWhoisRequest{
-				Id: args[0],
+			params := &types.QueryGetWhoisRequest{}
+			if types.IsWhoisId(args[0]) {
+				params.Id = args[0]
+			} else {
+				params.Name = types.CanonicalName(args[0])
 			}
 
 			res, err := queryClient.Whois(context.Background(), params)
 			if err != nil {
+				if isNotFound(err) {
+					return fmt.Errorf("no such whois: %s", args[0])
+				}
 				return err
 			}
 
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListWhoisTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-whois-transfer",
+		Short: "list all pending whois transfers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllWhoisTransferRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.WhoisTransferAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-whois-transfer")
+
+	return cmd
+}
+
+func CmdShowWhoisTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-whois-transfer [name]",
+		Short: "shows the pending transfer of a whois",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetWhoisTransferRequest{
+				Name: types.CanonicalName(args[0]),
+			}
+
+			res, err := queryClient.WhoisTransfer(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -25,6 +25,13 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateWhois())
 	cmd.AddCommand(CmdUpdateWhois())
 	cmd.AddCommand(CmdDeleteWhois())
+	cmd.AddCommand(CmdSetPrimaryName())
+	cmd.AddCommand(CmdRenewWhois())
+	cmd.AddCommand(CmdBuyWhois())
+	cmd.AddCommand(CmdSetWhoisForSale())
+	cmd.AddCommand(CmdTransferWhois())
+	cmd.AddCommand(CmdAcceptWhoisTransfer())
+	cmd.AddCommand(CmdCancelWhoisTransfer())
 
 	return cmd
 }
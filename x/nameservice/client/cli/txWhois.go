@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -15,7 +17,7 @@ func CmdCreateWhois() *cobra.Command {
 		Short: "Creates a new whois",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			argsName := string(args[0])
+			argsName := types.CanonicalName(args[0])
 			argsAddress := string(args[1])
 			argsPrice := string(args[2])
 
@@ -37,23 +39,43 @@ func CmdCreateWhois() *cobra.Command {
 	return cmd
 }
 
+// Flags of update-whois
+const (
+	FlagUpdateMask       = "update-mask"
+	FlagExpectedRevision = "expected-revision"
+)
+
 func CmdUpdateWhois() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-whois [id] [name] [address] [price]",
+		Use:   "update-whois [name] [new-name] [address] [price]",
 		Short: "Update a whois",
-		Args:  cobra.ExactArgs(4),
+		Long: `Update the whois registered under name, the whois can also be given by its id.
+With --update-mask only the listed fields out of name, address and price change, the
+arguments of the other fields are ignored. With --expected-revision the update fails if
+the whois changed since it was read at that revision.`,
+		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			id := args[0]
-			argsName := string(args[1])
+			whois := types.CanonicalName(args[0])
+			argsName := types.CanonicalName(args[1])
 			argsAddress := string(args[2])
 			argsPrice := string(args[3])
 
+			updateMask, err := cmd.Flags().GetStringSlice(FlagUpdateMask)
+			if err != nil {
+				return err
+			}
+
+			expectedRevision, err := cmd.Flags().GetUint64(FlagExpectedRevision)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgUpdateWhois(clientCtx.GetFromAddress().String(), id, string(argsName), string(argsAddress), string(argsPrice))
+			msg := types.NewMsgUpdateWhois(clientCtx.GetFromAddress().String(), whois, string(argsName), string(argsAddress), string(argsPrice), updateMask, expectedRevision)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -61,6 +83,8 @@ func CmdUpdateWhois() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringSlice(FlagUpdateMask, nil, "fields to update out of name, address and price, all of them by default")
+	cmd.Flags().Uint64(FlagExpectedRevision, 0, "revision the whois is expected to be at")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -68,18 +92,206 @@ func CmdUpdateWhois() *cobra.Command {
 
 func CmdDeleteWhois() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delete-whois [id] [name] [address] [price]",
-		Short: "Delete a whois by id",
+		Use:   "delete-whois [name]",
+		Short: "Delete the whois registered under name, or with the given id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			whois := types.CanonicalName(args[0])
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteWhois(clientCtx.GetFromAddress().String(), whois)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetPrimaryName() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-primary-name [name]",
+		Short: "Make a name resolving to your address its primary name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsName := types.CanonicalName(args[0])
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPrimaryName(clientCtx.GetFromAddress().String(), string(argsName))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRenewWhois() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew-whois [name]",
+		Short: "Extend the registration of a whois by one registration period",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsName := types.CanonicalName(args[0])
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenewWhois(clientCtx.GetFromAddress().String(), string(argsName))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBuyWhois() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-whois [name] [max-price]",
+		Short: "Buy a whois that is for sale, paying at most max-price",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsName := types.CanonicalName(args[0])
+			argsMaxPrice := string(args[1])
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBuyWhois(clientCtx.GetFromAddress().String(), string(argsName), string(argsMaxPrice))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetWhoisForSale() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-whois-for-sale [name] [for-sale]",
+		Short: "List or unlist a whois for sale",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsName := types.CanonicalName(args[0])
+			argsForSale, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetWhoisForSale(clientCtx.GetFromAddress().String(), string(argsName), argsForSale)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTransferWhois() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-whois [name] [recipient]",
+		Short: "Offer the ownership of a whois to a recipient",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsName := types.CanonicalName(args[0])
+			argsRecipient := string(args[1])
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferWhois(clientCtx.GetFromAddress().String(), string(argsName), string(argsRecipient))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAcceptWhoisTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-whois-transfer [name]",
+		Short: "Accept the ownership of a whois offered to you",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsName := types.CanonicalName(args[0])
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptWhoisTransfer(clientCtx.GetFromAddress().String(), string(argsName))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelWhoisTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-whois-transfer [name]",
+		Short: "Withdraw a pending ownership transfer of a whois",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			id := args[0]
+			argsName := types.CanonicalName(args[0])
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgDeleteWhois(clientCtx.GetFromAddress().String(), id)
+			msg := types.NewMsgCancelWhoisTransfer(clientCtx.GetFromAddress().String(), string(argsName))
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -0,0 +1,18 @@
+package cli
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// isNotFound tells whether a query failed because the requested record doesn't exist.
+// Queries routed through ABCI only hand the log of the status error back to the
+// client, so the code is also matched in the error text.
+func isNotFound(err error) bool {
+	if status.Code(err) == codes.NotFound {
+		return true
+	}
+	return strings.Contains(err.Error(), "code = "+codes.NotFound.String())
+}
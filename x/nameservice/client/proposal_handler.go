@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/enqack/nameservice/x/nameservice/client/cli"
+	"github.com/enqack/nameservice/x/nameservice/client/rest"
+)
+
+// SetTldProposalHandler is the set tld proposal handler
+var SetTldProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitSetTldProposal, rest.SetTldProposalRESTHandler)
@@ -0,0 +1,58 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"github.com/gorilla/mux"
+)
+
+// parsePageRequest builds a page request from the page and limit query arguments
+func parsePageRequest(r *http.Request) (*query.PageRequest, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit == 0 {
+		return nil, nil
+	}
+
+	return &query.PageRequest{Offset: uint64((page - 1) * limit), Limit: uint64(limit)}, nil
+}
+
+func namesByAddressHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address := mux.Vars(r)["address"]
+
+		pageReq, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.QueryNamesByAddressRequest{Address: address, Pagination: pageReq}
+		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := queryWithData(clientCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNamesByAddress), bz)
+		if err != nil {
+			writeQueryError(w, err, http.StatusNotFound)
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
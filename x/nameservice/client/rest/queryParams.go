@@ -0,0 +1,28 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+func paramsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := queryWithData(clientCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+		if err != nil {
+			writeQueryError(w, err, http.StatusInternalServerError)
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
@@ -0,0 +1,26 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"github.com/gorilla/mux"
+)
+
+func resolveHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+
+		res, height, err := queryWithData(clientCtx, fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryResolveName, name), nil)
+		if err != nil {
+			writeQueryError(w, err, http.StatusNotFound)
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
@@ -12,9 +12,9 @@ import (
 
 func listWhoisHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/list-whois", types.QuerierRoute), nil)
+		res, height, err := queryWithData(clientCtx, fmt.Sprintf("custom/%s/list-whois", types.QuerierRoute), nil)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			writeQueryError(w, err, http.StatusNotFound)
 			return
 		}
 
@@ -25,11 +25,11 @@ func listWhoisHandler(clientCtx client.Context) http.HandlerFunc {
 
 func getWhoisHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		id := mux.Vars(r)["id"]
+		name := types.CanonicalName(mux.Vars(r)["name"])
 
-		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/get-whois/%s", types.QuerierRoute, id), nil)
+		res, height, err := queryWithData(clientCtx, fmt.Sprintf("custom/%s/get-whois/%s", types.QuerierRoute, name), nil)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			writeQueryError(w, err, http.StatusNotFound)
 			return
 		}
 
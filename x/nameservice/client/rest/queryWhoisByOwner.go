@@ -0,0 +1,39 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"github.com/gorilla/mux"
+)
+
+func namesOwnedHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		owner := mux.Vars(r)["address"]
+
+		pageReq, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.QueryWhoisByOwnerRequest{Owner: owner, Pagination: pageReq}
+		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := queryWithData(clientCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryWhoisByOwner), bz)
+		if err != nil {
+			writeQueryError(w, err, http.StatusNotFound)
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
@@ -21,15 +21,26 @@ func RegisterRoutes(clientCtx client.Context, r *mux.Router) {
 
 func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 	// this line is used by starport scaffolding # 3
-	r.HandleFunc("/nameservice/whois/{id}", getWhoisHandler(clientCtx)).Methods("GET")
+	r.HandleFunc("/nameservice/whois/{name}", getWhoisHandler(clientCtx)).Methods("GET")
 	r.HandleFunc("/nameservice/whois", listWhoisHandler(clientCtx)).Methods("GET")
+	r.HandleFunc("/nameservice/resolve/{name}", resolveHandler(clientCtx)).Methods("GET")
+	r.HandleFunc("/nameservice/names/{address}", namesByAddressHandler(clientCtx)).Methods("GET")
+	r.HandleFunc("/nameservice/names-owned/{address}", namesOwnedHandler(clientCtx)).Methods("GET")
+	r.HandleFunc("/nameservice/params", paramsHandler(clientCtx)).Methods("GET")
 
 }
 
 func registerTxHandlers(clientCtx client.Context, r *mux.Router) {
 	// this line is used by starport scaffolding # 4
 	r.HandleFunc("/nameservice/whois", createWhoisHandler(clientCtx)).Methods("POST")
-	r.HandleFunc("/nameservice/whois/{id}", updateWhoisHandler(clientCtx)).Methods("POST")
-	r.HandleFunc("/nameservice/whois/{id}", deleteWhoisHandler(clientCtx)).Methods("POST")
+	r.HandleFunc("/nameservice/whois/{name}", updateWhoisHandler(clientCtx)).Methods("POST")
+	r.HandleFunc("/nameservice/whois/{name}/delete", deleteWhoisHandler(clientCtx)).Methods("POST")
+	r.HandleFunc("/nameservice/primary-name", setPrimaryNameHandler(clientCtx)).Methods("POST")
+	r.HandleFunc("/nameservice/renew", renewWhoisHandler(clientCtx)).Methods("POST")
+	r.HandleFunc("/nameservice/buy", buyWhoisHandler(clientCtx)).Methods("POST")
+	r.HandleFunc("/nameservice/for-sale", setWhoisForSaleHandler(clientCtx)).Methods("POST")
+	r.HandleFunc("/nameservice/transfer", transferWhoisHandler(clientCtx)).Methods("POST")
+	r.HandleFunc("/nameservice/transfer/accept", acceptWhoisTransferHandler(clientCtx)).Methods("POST")
+	r.HandleFunc("/nameservice/transfer/cancel", cancelWhoisTransferHandler(clientCtx)).Methods("POST")
 
 }
@@ -42,34 +42,44 @@ func createWhoisHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		parsedName := req.Name
+		parsedName := types.CanonicalName(req.Name)
 
 		parsedAddress := req.Address
 
-		parsedPrice := req.Price
+		parsedPrice, err := types.ParseCoins(req.Price)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
 		msg := types.NewMsgCreateWhois(
 			req.Creator,
 			parsedName,
 			parsedAddress,
-			parsedPrice,
+			parsedPrice.String(),
 		)
 
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
 
 type updateWhoisRequest struct {
-	BaseReq rest.BaseReq `json:"base_req"`
-	Creator string       `json:"creator"`
-	Name    string       `json:"name"`
-	Address string       `json:"address"`
-	Price   string       `json:"price"`
+	BaseReq          rest.BaseReq `json:"base_req"`
+	Creator          string       `json:"creator"`
+	Name             string       `json:"name"`
+	Address          string       `json:"address"`
+	Price            string       `json:"price"`
+	UpdateMask       []string     `json:"update_mask"`
+	ExpectedRevision string       `json:"expected_revision"`
 }
 
 func updateWhoisHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		id := mux.Vars(r)["id"]
+		whois := types.CanonicalName(mux.Vars(r)["name"])
 
 		var req updateWhoisRequest
 		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
@@ -88,20 +98,39 @@ func updateWhoisHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		parsedName := req.Name
+		parsedName := types.CanonicalName(req.Name)
 
 		parsedAddress := req.Address
 
-		parsedPrice := req.Price
+		parsedPrice, err := types.ParseCoins(req.Price)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var parsedExpectedRevision uint64
+		if req.ExpectedRevision != "" {
+			parsedExpectedRevision, err = strconv.ParseUint(req.ExpectedRevision, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
 
 		msg := types.NewMsgUpdateWhois(
 			req.Creator,
-			id,
+			whois,
 			parsedName,
 			parsedAddress,
-			parsedPrice,
+			parsedPrice.String(),
+			req.UpdateMask,
+			parsedExpectedRevision,
 		)
 
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
@@ -113,7 +142,7 @@ type deleteWhoisRequest struct {
 
 func deleteWhoisHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		id := mux.Vars(r)["id"]
+		whois := types.CanonicalName(mux.Vars(r)["name"])
 
 		var req deleteWhoisRequest
 		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
@@ -134,9 +163,291 @@ func deleteWhoisHandler(clientCtx client.Context) http.HandlerFunc {
 
 		msg := types.NewMsgDeleteWhois(
 			req.Creator,
-			id,
+			whois,
+		)
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type setPrimaryNameRequest struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Creator string       `json:"creator"`
+	Name    string       `json:"name"`
+}
+
+func setPrimaryNameHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setPrimaryNameRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		_, err := sdk.AccAddressFromBech32(req.Creator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetPrimaryName(
+			req.Creator,
+			types.CanonicalName(req.Name),
 		)
 
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type renewWhoisRequest struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Creator string       `json:"creator"`
+	Name    string       `json:"name"`
+}
+
+func renewWhoisHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req renewWhoisRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		_, err := sdk.AccAddressFromBech32(req.Creator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRenewWhois(
+			req.Creator,
+			types.CanonicalName(req.Name),
+		)
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type buyWhoisRequest struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Buyer    string       `json:"buyer"`
+	Name     string       `json:"name"`
+	MaxPrice string       `json:"max_price"`
+}
+
+func buyWhoisHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req buyWhoisRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		_, err := sdk.AccAddressFromBech32(req.Buyer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		parsedMaxPrice, err := types.ParseCoins(req.MaxPrice)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgBuyWhois(
+			req.Buyer,
+			types.CanonicalName(req.Name),
+			parsedMaxPrice.String(),
+		)
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type setWhoisForSaleRequest struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Creator string       `json:"creator"`
+	Name    string       `json:"name"`
+	ForSale bool         `json:"for_sale"`
+}
+
+func setWhoisForSaleHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setWhoisForSaleRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		_, err := sdk.AccAddressFromBech32(req.Creator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetWhoisForSale(
+			req.Creator,
+			types.CanonicalName(req.Name),
+			req.ForSale,
+		)
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type transferWhoisRequest struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Creator   string       `json:"creator"`
+	Name      string       `json:"name"`
+	Recipient string       `json:"recipient"`
+}
+
+func transferWhoisHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req transferWhoisRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		_, err := sdk.AccAddressFromBech32(req.Creator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgTransferWhois(
+			req.Creator,
+			types.CanonicalName(req.Name),
+			req.Recipient,
+		)
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type acceptWhoisTransferRequest struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Creator string       `json:"creator"`
+	Name    string       `json:"name"`
+}
+
+func acceptWhoisTransferHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req acceptWhoisTransferRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		_, err := sdk.AccAddressFromBech32(req.Creator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgAcceptWhoisTransfer(
+			req.Creator,
+			types.CanonicalName(req.Name),
+		)
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type cancelWhoisTransferRequest struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Creator string       `json:"creator"`
+	Name    string       `json:"name"`
+}
+
+func cancelWhoisTransferHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req cancelWhoisTransferRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		_, err := sdk.AccAddressFromBech32(req.Creator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCancelWhoisTransfer(
+			req.Creator,
+			types.CanonicalName(req.Name),
+		)
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+// queryWithData performs a query like client.Context.QueryWithData, but keeps the codespace
+// and code of a failed query so the error still matches the module errors
+func queryWithData(clientCtx client.Context, path string, data []byte) ([]byte, int64, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := rpcclient.ABCIQueryOptions{Height: clientCtx.Height}
+	result, err := node.ABCIQueryWithOptions(context.Background(), path, data, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res := result.Response
+	if !res.IsOK() {
+		return nil, res.Height, sdkerrors.ABCIError(res.Codespace, res.Code, res.Log)
+	}
+
+	return res.Value, res.Height, nil
+}
+
+// writeQueryError writes err with the HTTP status of the module error it wraps,
+// errors from outside the module are written with fallbackStatus
+func writeQueryError(w http.ResponseWriter, err error, fallbackStatus int) {
+	httpStatus := fallbackStatus
+	if code, ok := types.GRPCCode(err); ok {
+		httpStatus = runtime.HTTPStatusFromCode(code)
+	}
+
+	rest.WriteErrorResponse(w, httpStatus, err.Error())
+}
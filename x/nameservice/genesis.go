@@ -9,22 +9,43 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
-	k.SetParams(ctx, types.DefaultParams())
+	k.SetStoreVersion(ctx, types.ConsensusVersion)
+	k.SetParams(ctx, genState.Params)
 
 	// this line is used by starport scaffolding # genesis/module/init
-	// Set all the whois
+	// Set all the tld
+	for _, elem := range genState.TldList {
+		k.SetTld(ctx, *elem)
+	}
+
+	// Set all the whois, SetWhois also rebuilds the name index
 	for _, elem := range genState.WhoisList {
 		k.SetWhois(ctx, *elem)
 	}
 
+	// Set all the primary names, after the whois they resolve
+	for _, elem := range genState.PrimaryNameList {
+		k.SetPrimaryName(ctx, elem.Address, elem.Name)
+	}
+
+	// Set all the pending transfers, after the whois they hand over
+	for _, elem := range genState.WhoisTransferList {
+		k.SetWhoisTransfer(ctx, *elem)
+	}
+
+	k.SetFeeTotals(ctx, genState.FeeTotals)
+
 	// Set whois count
-	k.SetWhoisCount(ctx, int64(len(genState.WhoisList)))
+	k.SetWhoisCount(ctx, int64(genState.WhoisCount))
 
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
+	genesis.TldList = []*types.Tld{}
+	genesis.Params = k.GetParams(ctx)
+	genesis.FeeTotals = k.GetFeeTotals(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 	// Get all whois
@@ -34,5 +55,27 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.WhoisList = append(genesis.WhoisList, &elem)
 	}
 
+	// Set the whois count
+	genesis.WhoisCount = uint64(k.GetWhoisCount(ctx))
+
+	// Get all primary names
+	for _, elem := range k.GetAllPrimaryName(ctx) {
+		elem := elem
+		genesis.PrimaryNameList = append(genesis.PrimaryNameList, &elem)
+	}
+
+	// Get all pending transfers
+	for _, elem := range k.GetAllWhoisTransfer(ctx) {
+		elem := elem
+		genesis.WhoisTransferList = append(genesis.WhoisTransferList, &elem)
+	}
+
+	// Get all tld
+	tldList := k.GetAllTld(ctx)
+	for _, elem := range tldList {
+		elem := elem
+		genesis.TldList = append(genesis.TldList, &elem)
+	}
+
 	return genesis
 }
@@ -0,0 +1,171 @@
+package nameservice_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/enqack/nameservice/testutil/keeper"
+	"github.com/enqack/nameservice/x/nameservice"
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+var (
+	owner = sdk.AccAddress("owner_______________").String()
+	other = sdk.AccAddress("other_______________").String()
+)
+
+func TestGenesisRoundTripKeepsIdsUnique(t *testing.T) {
+	k, ctx, _ := keepertest.NameserviceKeeper(t)
+	nameservice.InitGenesis(ctx, *k, *types.DefaultGenesis())
+
+	for _, name := range []string{"alpha.wallet", "beta.wallet", "gamma.wallet"} {
+		k.CreateWhois(ctx, types.MsgCreateWhois{Creator: owner, Name: name, Address: owner, Price: "5trycoin"})
+	}
+	// With the first record gone the list is shorter than the highest id
+	k.DeleteWhois(ctx, "0")
+	k.SetPrimaryName(ctx, owner, "beta.wallet")
+	k.SetWhoisTransfer(ctx, types.WhoisTransfer{Id: "2", Name: "gamma.wallet", Owner: owner, Recipient: other})
+
+	genState := nameservice.ExportGenesis(ctx, *k)
+	require.NoError(t, genState.Validate())
+	require.Len(t, genState.WhoisList, 2)
+	require.EqualValues(t, 3, genState.WhoisCount)
+
+	imported, importedCtx, _ := keepertest.NameserviceKeeper(t)
+	nameservice.InitGenesis(importedCtx, *imported, *genState)
+	require.Equal(t, genState, nameservice.ExportGenesis(importedCtx, *imported))
+
+	whois := imported.CreateWhois(importedCtx, types.MsgCreateWhois{Creator: owner, Name: "delta.wallet", Address: owner, Price: "5trycoin"})
+	require.Equal(t, "3", whois.Id)
+
+	// The records that survived the export are untouched
+	for _, id := range []string{"1", "2"} {
+		_, found := imported.GetWhois(importedCtx, id)
+		require.True(t, found, "whois %s", id)
+	}
+	gamma, found := imported.GetWhoisByName(importedCtx, "gamma.wallet")
+	require.True(t, found)
+	require.Equal(t, "2", gamma.Id)
+
+	primary, found := imported.GetPrimaryName(importedCtx, owner)
+	require.True(t, found)
+	require.Equal(t, "beta.wallet", primary)
+
+	transfer, found := imported.GetWhoisTransfer(importedCtx, "2")
+	require.True(t, found)
+	require.Equal(t, other, transfer.Recipient)
+}
+
+func TestGenesisRejectsIdsAtOrAboveCount(t *testing.T) {
+	genState := types.DefaultGenesis()
+	genState.WhoisCount = 1
+	genState.WhoisList = []*types.Whois{
+		{Id: "1", Name: "alpha.wallet", Creator: owner, Address: owner, Price: "5trycoin"},
+	}
+	require.Error(t, genState.Validate())
+
+	genState.WhoisCount = 2
+	require.NoError(t, genState.Validate())
+}
+
+func TestGenesisValidatesPrimaryNames(t *testing.T) {
+	genState := types.DefaultGenesis()
+	genState.WhoisCount = 1
+	genState.WhoisList = []*types.Whois{
+		{Id: "0", Name: "alpha.wallet", Creator: owner, Address: owner, Price: "5trycoin"},
+	}
+
+	for _, tc := range []struct {
+		desc         string
+		primaryNames []*types.PrimaryName
+		valid        bool
+	}{
+		{desc: "Resolving", primaryNames: []*types.PrimaryName{{Address: owner, Name: "alpha.wallet"}}, valid: true},
+		{desc: "Unregistered", primaryNames: []*types.PrimaryName{{Address: owner, Name: "beta.wallet"}}},
+		{desc: "OtherAddress", primaryNames: []*types.PrimaryName{{Address: other, Name: "alpha.wallet"}}},
+		{
+			desc: "Duplicated",
+			primaryNames: []*types.PrimaryName{
+				{Address: owner, Name: "alpha.wallet"},
+				{Address: owner, Name: "alpha.wallet"},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			genState.PrimaryNameList = tc.primaryNames
+			if tc.valid {
+				require.NoError(t, genState.Validate())
+			} else {
+				require.Error(t, genState.Validate())
+			}
+		})
+	}
+}
+
+func TestGenesisValidatesWhoisTransfers(t *testing.T) {
+	genState := types.DefaultGenesis()
+	genState.WhoisCount = 2
+	genState.WhoisList = []*types.Whois{
+		{Id: "0", Name: "alpha.wallet", Creator: owner, Address: owner, Price: "5trycoin"},
+		{Id: "1", Name: "beta.wallet", Creator: owner, Address: owner, Price: "5trycoin"},
+	}
+
+	for _, tc := range []struct {
+		desc      string
+		transfers []*types.WhoisTransfer
+		valid     bool
+	}{
+		{desc: "Matching", transfers: []*types.WhoisTransfer{{Id: "0", Name: "alpha.wallet", Owner: owner, Recipient: other}}, valid: true},
+		{desc: "Unregistered", transfers: []*types.WhoisTransfer{{Id: "2", Name: "gamma.wallet", Owner: owner, Recipient: other}}},
+		{desc: "OtherId", transfers: []*types.WhoisTransfer{{Id: "1", Name: "alpha.wallet", Owner: owner, Recipient: other}}},
+		{desc: "NotOwner", transfers: []*types.WhoisTransfer{{Id: "0", Name: "alpha.wallet", Owner: other, Recipient: owner}}},
+		{desc: "ToOwner", transfers: []*types.WhoisTransfer{{Id: "0", Name: "alpha.wallet", Owner: owner, Recipient: owner}}},
+		{desc: "InvalidRecipient", transfers: []*types.WhoisTransfer{{Id: "0", Name: "alpha.wallet", Owner: owner, Recipient: "recipient"}}},
+		{
+			desc: "Duplicated",
+			transfers: []*types.WhoisTransfer{
+				{Id: "0", Name: "alpha.wallet", Owner: owner, Recipient: other},
+				{Id: "0", Name: "alpha.wallet", Owner: owner, Recipient: other},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			genState.WhoisTransferList = tc.transfers
+			if tc.valid {
+				require.NoError(t, genState.Validate())
+			} else {
+				require.Error(t, genState.Validate())
+			}
+		})
+	}
+}
+
+func TestGenesisValidatesWhoisPrices(t *testing.T) {
+	for _, tc := range []struct {
+		price string
+		valid bool
+	}{
+		{price: "5trycoin", valid: true},
+		// Names registered before prices had to be positive
+		{price: "", valid: true},
+		{price: "0trycoin", valid: true},
+		{price: "5"},
+		{price: "1.5trycoin"},
+		{price: "-5trycoin"},
+	} {
+		genState := types.DefaultGenesis()
+		genState.WhoisCount = 1
+		genState.WhoisList = []*types.Whois{
+			{Id: "0", Name: "alpha.wallet", Creator: owner, Address: owner, Price: tc.price},
+		}
+		if tc.valid {
+			require.NoError(t, genState.Validate(), "price %q", tc.price)
+		} else {
+			require.Error(t, genState.Validate(), "price %q", tc.price)
+		}
+	}
+}
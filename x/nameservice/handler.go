@@ -9,21 +9,54 @@ import (
 	"github.com/enqack/nameservice/x/nameservice/types"
 )
 
-// NewHandler ...
+// NewHandler returns a handler for the legacy amino routed messages, it hands them to the Msg service
 func NewHandler(k keeper.Keeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(k)
+
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 		switch msg := msg.(type) {
 		// this line is used by starport scaffolding # 1
 		case *types.MsgCreateWhois:
-			return handleMsgCreateWhois(ctx, k, msg)
+			res, err := msgServer.CreateWhois(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		case *types.MsgUpdateWhois:
-			return handleMsgUpdateWhois(ctx, k, msg)
+			res, err := msgServer.UpdateWhois(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		case *types.MsgDeleteWhois:
-			return handleMsgDeleteWhois(ctx, k, msg)
+			res, err := msgServer.DeleteWhois(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetPrimaryName:
+			res, err := msgServer.SetPrimaryName(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRenewWhois:
+			res, err := msgServer.RenewWhois(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgBuyWhois:
+			res, err := msgServer.BuyWhois(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetWhoisForSale:
+			res, err := msgServer.SetWhoisForSale(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgTransferWhois:
+			res, err := msgServer.TransferWhois(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAcceptWhoisTransfer:
+			res, err := msgServer.AcceptWhoisTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCancelWhoisTransfer:
+			res, err := msgServer.CancelWhoisTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+// IsExpired - check if the registration of a whois has lapsed, nothing lapses while a
+// registration period of 0 disables expiry
+func (k Keeper) IsExpired(ctx sdk.Context, whois types.Whois) bool {
+	return whois.Expires > 0 && ctx.BlockHeight() >= whois.Expires && k.RegistrationPeriod(ctx) > 0
+}
+
+// RenewWhois extends the registration of a whois by one registration period
+func (k Keeper) RenewWhois(ctx sdk.Context, whois types.Whois) types.Whois {
+	// Renewing a lapsed whois starts the new period from the current height
+	base := whois.Expires
+	if base < ctx.BlockHeight() {
+		base = ctx.BlockHeight()
+	}
+	whois.Expires = base + k.RegistrationPeriod(ctx)
+
+	return k.SetWhois(ctx, whois)
+}
+
+// ReleaseExpiredWhois deletes the whois whose grace period has elapsed, releasing
+// at most MaxExpirationsPerBlock names. The rest are picked up in the next blocks.
+func (k Keeper) ReleaseExpiredWhois(ctx sdk.Context) {
+	// Owners can't renew while expiry is disabled, so nothing is released either. The
+	// queue is kept and picked up again once a registration period is set.
+	if k.RegistrationPeriod(ctx) == 0 {
+		return
+	}
+
+	cutoff := ctx.BlockHeight() - k.ExpiryGracePeriod(ctx)
+	if cutoff <= 0 {
+		return
+	}
+
+	// Collect the ids first, the queue can't be modified while iterating
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisExpiryKeyPrefix)
+	iterator := expiryStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(cutoff+1)))
+
+	var keys [][]byte
+	limit := k.MaxExpirationsPerBlock(ctx)
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		id := types.WhoisIdFromKey(expiryStore.Get(key))
+		whois, found := k.GetWhois(ctx, id)
+		if !found {
+			// Drop the stale entry so it doesn't hold up the queue
+			expiryStore.Delete(key)
+			k.Logger(ctx).Error("dropped expiry queue entry of missing whois", "id", id)
+			continue
+		}
+
+		k.DeleteWhois(ctx, id)
+
+		err := ctx.EventManager().EmitTypedEvent(&types.EventWhoisReleased{
+			Id:      whois.Id,
+			Name:    whois.Name,
+			Owner:   whois.Creator,
+			Address: whois.Address,
+			Expires: whois.Expires,
+		})
+		if err != nil {
+			// EndBlock can't fail, the release itself already happened
+			k.Logger(ctx).Error("failed to emit release event", "id", whois.Id, "err", err)
+		}
+
+		k.Logger(ctx).Info("released expired whois", "id", whois.Id, "name", whois.Name)
+	}
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/enqack/nameservice/testutil/keeper"
+	"github.com/enqack/nameservice/x/nameservice/keeper"
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+func TestReleaseExpiredWhois(t *testing.T) {
+	k, ctx, _ := keepertest.NameserviceKeeper(t)
+	params := types.DefaultParams()
+	params.RegistrationPeriod = 10
+	params.ExpiryGracePeriod = 5
+	params.MaxExpirationsPerBlock = 2
+	k.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(1)
+	for i := 0; i < 3; i++ {
+		whois := k.CreateWhois(ctx, types.MsgCreateWhois{Creator: owner, Name: fmt.Sprintf("name%d.wallet", i), Address: owner, Price: "5trycoin"})
+		require.EqualValues(t, 11, whois.Expires)
+	}
+
+	// Expired but still within the grace period
+	ctx = ctx.WithBlockHeight(15)
+	whois, _ := k.GetWhoisByName(ctx, "name0.wallet")
+	require.True(t, k.IsExpired(ctx, whois))
+	k.ReleaseExpiredWhois(ctx)
+	require.Len(t, k.GetAllWhois(ctx), 3)
+
+	// At most MaxExpirationsPerBlock are released per block, the rest follow
+	ctx = ctx.WithBlockHeight(16)
+	k.ReleaseExpiredWhois(ctx)
+	require.Len(t, k.GetAllWhois(ctx), 1)
+	ctx = ctx.WithBlockHeight(17)
+	k.ReleaseExpiredWhois(ctx)
+	require.Empty(t, k.GetAllWhois(ctx))
+	require.False(t, k.IsNamePresent(ctx, "name2.wallet"))
+
+	msg, broken := keeper.AllInvariants(*k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestReleaseExpiredWhoisDisabled(t *testing.T) {
+	k, ctx, _ := keepertest.NameserviceKeeper(t)
+	params := types.DefaultParams()
+	params.RegistrationPeriod = 10
+	params.ExpiryGracePeriod = 5
+	k.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(1)
+	created := k.CreateWhois(ctx, types.MsgCreateWhois{Creator: owner, Name: "alpha.wallet", Address: owner, Price: "5trycoin"})
+
+	// Disabling expiry keeps lapsed names, since their owners can't renew them
+	params.RegistrationPeriod = 0
+	k.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(100)
+	require.False(t, k.IsExpired(ctx, created))
+	k.ReleaseExpiredWhois(ctx)
+	require.True(t, k.HasWhois(ctx, created.Id))
+
+	// Enabling it again releases them
+	params.RegistrationPeriod = 10
+	k.SetParams(ctx, params)
+	require.True(t, k.IsExpired(ctx, created))
+	k.ReleaseExpiredWhois(ctx)
+	require.False(t, k.HasWhois(ctx, created.Id))
+}
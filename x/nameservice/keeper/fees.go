@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+// ChargeFee collects fee from payer into the module account and forwards it to the fee destination
+func (k Keeper) ChargeFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	if err := k.CoinKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, fee); err != nil {
+		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
+			return sdkerrors.Wrapf(types.ErrInsufficientFee, "fee %s: %s", fee, err)
+		}
+		return err
+	}
+
+	totals := k.GetFeeTotals(ctx)
+	totals.Collected = totals.Collected.Add(fee...)
+
+	destination := k.FeeDestination(ctx)
+	switch destination {
+	case types.FeeDestinationBurn:
+		if err := k.CoinKeeper.BurnCoins(ctx, types.ModuleName, fee); err != nil {
+			return err
+		}
+		totals.Burned = totals.Burned.Add(fee...)
+	case types.FeeDestinationCommunityPool:
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+		if err := k.DistrKeeper.FundCommunityPool(ctx, fee, moduleAddr); err != nil {
+			return err
+		}
+		totals.CommunityPool = totals.CommunityPool.Add(fee...)
+	case types.FeeDestinationTreasury:
+		totals.Treasury = totals.Treasury.Add(fee...)
+	default:
+		return fmt.Errorf("invalid fee destination: %s", destination)
+	}
+
+	k.SetFeeTotals(ctx, totals)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventFeeCharged{
+		Payer:       payer.String(),
+		Fee:         fee,
+		Destination: destination,
+	})
+}
+
+// GetFeeTotals returns the fee totals collected by the module
+func (k Keeper) GetFeeTotals(ctx sdk.Context) types.FeeTotals {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeTotalsKey)
+	if bz == nil {
+		return types.FeeTotals{}
+	}
+
+	var totals types.FeeTotals
+	k.cdc.MustUnmarshalBinaryBare(bz, &totals)
+	return totals
+}
+
+// SetFeeTotals set the fee totals collected by the module
+func (k Keeper) SetFeeTotals(ctx sdk.Context, totals types.FeeTotals) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&totals)
+	store.Set(types.FeeTotalsKey, bz)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) FeeTotals(c context.Context, req *types.QueryFeeTotalsRequest) (*types.QueryFeeTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeeTotalsResponse{
+		FeeTotals:      k.GetFeeTotals(ctx),
+		FeeDestination: k.FeeDestination(ctx),
+	}, nil
+}
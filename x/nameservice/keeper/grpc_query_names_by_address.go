@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) NamesByAddress(c context.Context, req *types.QueryNamesByAddressRequest) (*types.QueryNamesByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var names []string
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Only answer for names whose forward record still resolves to the address
+	resolvesTo := func(name string) bool {
+		whois, found := k.GetWhoisByName(ctx, name)
+		return found && whois.Address == req.Address
+	}
+
+	addressStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisAddressPrefix(req.Address))
+
+	pageRes, err := query.Paginate(addressStore, req.Pagination, func(key []byte, value []byte) error {
+		if name := string(key); resolvesTo(name) {
+			names = append(names, name)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var primaryName string
+	if primary, found := k.GetPrimaryName(ctx, req.Address); found && resolvesTo(primary) {
+		primaryName = primary
+	}
+
+	return &types.QueryNamesByAddressResponse{PrimaryName: primaryName, Names: names, Pagination: pageRes}, nil
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RegistrationCost(c context.Context, req *types.QueryRegistrationCostRequest) (*types.QueryRegistrationCostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := k.ValidateNameFormat(ctx, req.Name); err != nil {
+		return nil, types.ToGRPCError(err)
+	}
+
+	return &types.QueryRegistrationCostResponse{
+		Cost:      k.GetRegistrationCost(ctx, req.Name),
+		Available: !k.IsNamePresent(ctx, req.Name),
+	}, nil
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Resolve(c context.Context, req *types.QueryResolveRequest) (*types.QueryResolveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	name := types.CanonicalName(req.Name)
+	whois, found := k.GetWhoisByName(ctx, name)
+	if !found {
+		return nil, types.ToGRPCError(sdkerrors.Wrapf(types.ErrNameNotFound, "name %s is not registered", name))
+	}
+
+	return &types.QueryResolveResponse{Address: whois.Address, Whois: &whois}, nil
+}
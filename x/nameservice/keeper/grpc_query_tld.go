@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Tlds(c context.Context, req *types.QueryTldsRequest) (*types.QueryTldsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var tlds []*types.Tld
+	ctx := sdk.UnwrapSDKContext(c)
+
+	for _, tld := range k.GetAllTld(ctx) {
+		tld := tld
+		if tld.Enabled || req.IncludeDisabled {
+			tlds = append(tlds, &tld)
+		}
+	}
+
+	return &types.QueryTldsResponse{Tld: tlds}, nil
+}
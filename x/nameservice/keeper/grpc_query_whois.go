@@ -20,7 +20,7 @@ func (k Keeper) WhoisAll(c context.Context, req *types.QueryAllWhoisRequest) (*t
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(k.storeKey)
-	whoisStore := prefix.NewStore(store, types.KeyPrefix(types.WhoisKey))
+	whoisStore := prefix.NewStore(store, types.WhoisKeyPrefix)
 
 	pageRes, err := query.Paginate(whoisStore, req.Pagination, func(key []byte, value []byte) error {
 		var whois types.Whois
@@ -44,11 +44,12 @@ func (k Keeper) Whois(c context.Context, req *types.QueryGetWhoisRequest) (*type
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var whois types.Whois
 	ctx := sdk.UnwrapSDKContext(c)
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhoisKey))
-	k.cdc.MustUnmarshalBinaryBare(store.Get(types.KeyPrefix(types.WhoisKey+req.Id)), &whois)
+	whois, err := k.getWhoisByNameOrId(ctx, types.CanonicalName(req.Name), req.Id)
+	if err != nil {
+		return nil, types.ToGRPCError(err)
+	}
 
 	return &types.QueryGetWhoisResponse{Whois: &whois}, nil
 }
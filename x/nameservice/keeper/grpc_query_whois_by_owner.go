@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) WhoisByOwner(c context.Context, req *types.QueryWhoisByOwnerRequest) (*types.QueryWhoisByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var whoiss []*types.Whois
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisOwnerPrefix(req.Owner))
+
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(key []byte, value []byte) error {
+		id := types.WhoisIdFromKey(value)
+		whois, found := k.GetWhois(ctx, id)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrWhoisNotFound, "id %s", id)
+		}
+		whoiss = append(whoiss, &whois)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWhoisByOwnerResponse{Whois: whoiss, Pagination: pageRes}, nil
+}
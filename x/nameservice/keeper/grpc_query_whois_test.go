@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/enqack/nameservice/testutil/keeper"
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+func TestWhoisQuery(t *testing.T) {
+	k, ctx, _ := keepertest.NameserviceKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	created := k.CreateWhois(ctx, types.MsgCreateWhois{Creator: owner, Name: "alpha.wallet", Address: owner, Price: "5trycoin"})
+
+	for _, tc := range []struct {
+		desc string
+		req  *types.QueryGetWhoisRequest
+		code codes.Code
+	}{
+		{desc: "ById", req: &types.QueryGetWhoisRequest{Id: created.Id}, code: codes.OK},
+		{desc: "ByName", req: &types.QueryGetWhoisRequest{Name: created.Name}, code: codes.OK},
+		{desc: "MissingId", req: &types.QueryGetWhoisRequest{Id: "1"}, code: codes.NotFound},
+		{desc: "InvalidId", req: &types.QueryGetWhoisRequest{Id: "alpha"}, code: codes.NotFound},
+		{desc: "MissingName", req: &types.QueryGetWhoisRequest{Name: "beta.wallet"}, code: codes.NotFound},
+		{desc: "NilRequest", code: codes.InvalidArgument},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := k.Whois(wctx, tc.req)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Equal(t, created, *res.Whois)
+			}
+		})
+	}
+}
+
+func TestResolveQuery(t *testing.T) {
+	k, ctx, _ := keepertest.NameserviceKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	created := k.CreateWhois(ctx, types.MsgCreateWhois{Creator: owner, Name: "alpha.wallet", Address: owner, Price: "5trycoin"})
+
+	res, err := k.Resolve(wctx, &types.QueryResolveRequest{Name: created.Name})
+	require.NoError(t, err)
+	require.Equal(t, owner, res.Address)
+
+	_, err = k.Resolve(wctx, &types.QueryResolveRequest{Name: "beta.wallet"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	k.DeleteWhois(ctx, created.Id)
+	_, err = k.Resolve(wctx, &types.QueryResolveRequest{Name: created.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/enqack/nameservice/x/nameservice/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) WhoisTransferAll(c context.Context, req *types.QueryAllWhoisTransferRequest) (*types.QueryAllWhoisTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var transfers []*types.WhoisTransfer
+	ctx := sdk.UnwrapSDKContext(c)
+
+	transferStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisTransferKeyPrefix)
+
+	pageRes, err := query.Paginate(transferStore, req.Pagination, func(key []byte, value []byte) error {
+		var transfer types.WhoisTransfer
+		if err := k.cdc.UnmarshalBinaryBare(value, &transfer); err != nil {
+			return err
+		}
+
+		transfers = append(transfers, &transfer)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllWhoisTransferResponse{WhoisTransfer: transfers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) WhoisTransfer(c context.Context, req *types.QueryGetWhoisTransferRequest) (*types.QueryGetWhoisTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	name := types.CanonicalName(req.Name)
+	id, found := k.GetWhoisIdByName(ctx, name)
+	if !found {
+		return nil, types.ToGRPCError(sdkerrors.Wrapf(types.ErrNameNotFound, "name %s is not registered", name))
+	}
+
+	transfer, found := k.GetWhoisTransfer(ctx, id)
+	if !found {
+		return nil, types.ToGRPCError(sdkerrors.Wrapf(types.ErrTransferNotFound, "name %s", name))
+	}
+
+	return &types.QueryGetWhoisTransferResponse{WhoisTransfer: &transfer}, nil
+}
@@ -0,0 +1,332 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+// RegisterInvariants registers all nameservice invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "unique-names", UniqueNamesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "whois-count", WhoisCountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "name-index", NameIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "address-index", AddressIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "owner-index", OwnerIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "primary-names", PrimaryNamesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "expiry-queue", ExpiryQueueInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "whois-transfers", WhoisTransfersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the nameservice module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			UniqueNamesInvariant(k),
+			WhoisCountInvariant(k),
+			NameIndexInvariant(k),
+			AddressIndexInvariant(k),
+			OwnerIndexInvariant(k),
+			PrimaryNamesInvariant(k),
+			ExpiryQueueInvariant(k),
+			WhoisTransfersInvariant(k),
+			ModuleBalanceInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// UniqueNamesInvariant checks that no two whois share a name
+func UniqueNamesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		owners := make(map[string]string)
+		for _, whois := range k.GetAllWhois(ctx) {
+			if id, ok := owners[whois.Name]; ok {
+				broken = true
+				msg += fmt.Sprintf("name %s is held by whois %s and %s\n", whois.Name, id, whois.Id)
+			}
+			owners[whois.Name] = whois.Id
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "unique-names",
+			fmt.Sprintf("found duplicated names\n%s", msg),
+		), broken
+	}
+}
+
+// WhoisCountInvariant checks that the whois count is greater than every stored id
+func WhoisCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		count := k.GetWhoisCount(ctx)
+		for _, whois := range k.GetAllWhois(ctx) {
+			id, err := strconv.ParseInt(whois.Id, 10, 64)
+			if err != nil || id >= count {
+				broken = true
+				msg += fmt.Sprintf("whois id %s is not below the whois count %d\n", whois.Id, count)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "whois-count",
+			fmt.Sprintf("found whois ids that would be reused\n%s", msg),
+		), broken
+	}
+}
+
+// NameIndexInvariant checks that the name index matches the whois records
+func NameIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		// Every whois must be indexed under its name
+		whoisList := k.GetAllWhois(ctx)
+		for _, whois := range whoisList {
+			id, found := k.GetWhoisIdByName(ctx, whois.Name)
+			if !found || id != whois.Id {
+				broken = true
+				msg += fmt.Sprintf("whois %s is not indexed under name %s\n", whois.Id, whois.Name)
+			}
+		}
+
+		// Every index entry must point to a whois with that name
+		nameStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisNameKeyPrefix)
+		iterator := nameStore.Iterator(nil, nil)
+		defer iterator.Close()
+
+		indexed := 0
+		for ; iterator.Valid(); iterator.Next() {
+			indexed++
+			name, id := string(iterator.Key()), types.WhoisIdFromKey(iterator.Value())
+			if whois, found := k.GetWhois(ctx, id); !found || whois.Name != name {
+				broken = true
+				msg += fmt.Sprintf("name %s is indexed to missing or renamed whois %s\n", name, id)
+			}
+		}
+
+		if indexed != len(whoisList) {
+			broken = true
+			msg += fmt.Sprintf("name index holds %d entries for %d whois\n", indexed, len(whoisList))
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "name-index",
+			fmt.Sprintf("found broken name index entries\n%s", msg),
+		), broken
+	}
+}
+
+// AddressIndexInvariant checks that the address index matches the whois records
+func AddressIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := checkAccountIndex(ctx, k, types.WhoisAddressKeyPrefix, types.WhoisAddressPrefix,
+			func(whois types.Whois) string { return whois.Address })
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "address-index",
+			fmt.Sprintf("found broken address index entries\n%s", msg),
+		), broken
+	}
+}
+
+// OwnerIndexInvariant checks that the owner index matches the whois records
+func OwnerIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := checkAccountIndex(ctx, k, types.WhoisOwnerKeyPrefix, types.WhoisOwnerPrefix,
+			func(whois types.Whois) string { return whois.Creator })
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "owner-index",
+			fmt.Sprintf("found broken owner index entries\n%s", msg),
+		), broken
+	}
+}
+
+// checkAccountIndex checks an index of names keyed by an account of the whois, both ways
+func checkAccountIndex(
+	ctx sdk.Context, k Keeper, indexKey []byte, indexPrefix func(string) []byte, account func(types.Whois) string,
+) (msg string, broken bool) {
+	// Every whois must be indexed under its account
+	for _, whois := range k.GetAllWhois(ctx) {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix(account(whois)))
+		if !bytes.Equal(store.Get([]byte(whois.Name)), types.WhoisIdKey(whois.Id)) {
+			broken = true
+			msg += fmt.Sprintf("whois %s is not indexed under %s/%s\n", whois.Id, account(whois), whois.Name)
+		}
+	}
+
+	// Every index entry must point to a whois with that account and name
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := types.WhoisIdFromKey(iterator.Value())
+		indexed, name, ok := types.SplitWhoisAccountKey(iterator.Key())
+		whois, found := k.GetWhois(ctx, id)
+		if !ok || !found {
+			broken = true
+			msg += fmt.Sprintf("%X is indexed to missing whois %s\n", iterator.Key(), id)
+			continue
+		}
+
+		if account(whois) != indexed || whois.Name != name {
+			broken = true
+			msg += fmt.Sprintf("%s/%s is indexed to whois %s of %s/%s\n", indexed, name, id, account(whois), whois.Name)
+		}
+	}
+
+	return msg, broken
+}
+
+// PrimaryNamesInvariant checks that every primary name resolves to its address
+func PrimaryNamesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisPrimaryKeyPrefix)
+		iterator := store.Iterator(nil, nil)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			address, name := string(iterator.Key()), string(iterator.Value())
+			whois, found := k.GetWhoisByName(ctx, name)
+			if !found || whois.Address != address {
+				broken = true
+				msg += fmt.Sprintf("primary name %s of %s does not resolve to it\n", name, address)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "primary-names",
+			fmt.Sprintf("found stale primary names\n%s", msg),
+		), broken
+	}
+}
+
+// ExpiryQueueInvariant checks that the expiry queue matches the expiry heights of the whois records
+func ExpiryQueueInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisExpiryKeyPrefix)
+
+		// Every expiring whois must be queued at its expiry height
+		expiring := 0
+		for _, whois := range k.GetAllWhois(ctx) {
+			if whois.Expires == 0 {
+				continue
+			}
+			expiring++
+			if !expiryStore.Has(types.WhoisExpiryQueueKey(whois.Expires, whois.Id)) {
+				broken = true
+				msg += fmt.Sprintf("whois %s is not queued to expire at %d\n", whois.Id, whois.Expires)
+			}
+		}
+
+		// Every queue entry must point to a whois expiring at that height
+		iterator := expiryStore.Iterator(nil, nil)
+		defer iterator.Close()
+
+		queued := 0
+		for ; iterator.Valid(); iterator.Next() {
+			queued++
+			id := types.WhoisIdFromKey(iterator.Value())
+			whois, found := k.GetWhois(ctx, id)
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("missing whois %s is queued to expire\n", id)
+				continue
+			}
+
+			if string(types.WhoisExpiryQueueKey(whois.Expires, whois.Id)) != string(iterator.Key()) {
+				broken = true
+				msg += fmt.Sprintf("whois %s is queued at another height than %d\n", id, whois.Expires)
+			}
+		}
+
+		if queued != expiring {
+			broken = true
+			msg += fmt.Sprintf("expiry queue holds %d entries for %d expiring whois\n", queued, expiring)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "expiry-queue",
+			fmt.Sprintf("found broken expiry queue entries\n%s", msg),
+		), broken
+	}
+}
+
+// WhoisTransfersInvariant checks that every pending transfer is offered by the current owner of the name
+func WhoisTransfersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, transfer := range k.GetAllWhoisTransfer(ctx) {
+			whois, found := k.GetWhois(ctx, transfer.Id)
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("transfer of %s points to missing whois %s\n", transfer.Name, transfer.Id)
+				continue
+			}
+
+			if whois.Name != transfer.Name || whois.Creator != transfer.Owner {
+				broken = true
+				msg += fmt.Sprintf("transfer of %s by %s does not match whois %s\n", transfer.Name, transfer.Owner, whois.Id)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "whois-transfers",
+			fmt.Sprintf("found stale whois transfers\n%s", msg),
+		), broken
+	}
+}
+
+// ModuleBalanceInvariant checks that the module account holds exactly the fees kept in the treasury
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		balance := k.CoinKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		treasury := k.GetFeeTotals(ctx).Treasury
+
+		broken := balance.String() != treasury.String()
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-balance",
+			fmt.Sprintf("\tmodule account balance: %s\n\ttreasury fee total: %s\n", balance, treasury),
+		), broken
+	}
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/enqack/nameservice/testutil/keeper"
+	"github.com/enqack/nameservice/x/nameservice/keeper"
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+func TestInvariantsReportBrokenState(t *testing.T) {
+	other := sdk.AccAddress("other_______________").String()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	for _, tc := range []struct {
+		desc      string
+		invariant func(keeper.Keeper) sdk.Invariant
+		corrupt   func(k *keeper.Keeper, ctx sdk.Context, store sdk.KVStore)
+	}{
+		{
+			desc:      "DuplicatedName",
+			invariant: keeper.UniqueNamesInvariant,
+			corrupt: func(k *keeper.Keeper, ctx sdk.Context, store sdk.KVStore) {
+				beta, _ := k.GetWhoisByName(ctx, "beta.wallet")
+				beta.Name = "alpha.wallet"
+				prefix.NewStore(store, types.WhoisKeyPrefix).Set(types.WhoisIdKey(beta.Id), cdc.MustMarshalBinaryBare(&beta))
+			},
+		},
+		{
+			desc:      "ReusedId",
+			invariant: keeper.WhoisCountInvariant,
+			corrupt: func(k *keeper.Keeper, ctx sdk.Context, store sdk.KVStore) {
+				k.SetWhoisCount(ctx, 1)
+			},
+		},
+		{
+			desc:      "MissingName",
+			invariant: keeper.NameIndexInvariant,
+			corrupt: func(k *keeper.Keeper, ctx sdk.Context, store sdk.KVStore) {
+				prefix.NewStore(store, types.WhoisNameKeyPrefix).Delete([]byte("alpha.wallet"))
+			},
+		},
+		{
+			desc:      "MissingAddress",
+			invariant: keeper.AddressIndexInvariant,
+			corrupt: func(k *keeper.Keeper, ctx sdk.Context, store sdk.KVStore) {
+				prefix.NewStore(store, types.WhoisAddressPrefix(owner)).Delete([]byte("alpha.wallet"))
+			},
+		},
+		{
+			desc:      "StaleOwner",
+			invariant: keeper.OwnerIndexInvariant,
+			corrupt: func(k *keeper.Keeper, ctx sdk.Context, store sdk.KVStore) {
+				prefix.NewStore(store, types.WhoisOwnerPrefix(other)).Set([]byte("alpha.wallet"), types.WhoisIdKey("0"))
+			},
+		},
+		{
+			desc:      "PrimaryNameOfOtherAddress",
+			invariant: keeper.PrimaryNamesInvariant,
+			corrupt: func(k *keeper.Keeper, ctx sdk.Context, store sdk.KVStore) {
+				prefix.NewStore(store, types.WhoisPrimaryKeyPrefix).Set([]byte(owner), []byte("beta.wallet"))
+			},
+		},
+		{
+			desc:      "NotQueued",
+			invariant: keeper.ExpiryQueueInvariant,
+			corrupt: func(k *keeper.Keeper, ctx sdk.Context, store sdk.KVStore) {
+				alpha, _ := k.GetWhoisByName(ctx, "alpha.wallet")
+				prefix.NewStore(store, types.WhoisExpiryKeyPrefix).Delete(types.WhoisExpiryQueueKey(alpha.Expires, alpha.Id))
+			},
+		},
+		{
+			desc:      "TransferOfFormerOwner",
+			invariant: keeper.WhoisTransfersInvariant,
+			corrupt: func(k *keeper.Keeper, ctx sdk.Context, store sdk.KVStore) {
+				k.SetWhoisTransfer(ctx, types.WhoisTransfer{Id: "0", Name: "alpha.wallet", Owner: other, Recipient: buyer})
+			},
+		},
+		{
+			desc:      "UnaccountedBalance",
+			invariant: keeper.ModuleBalanceInvariant,
+			corrupt: func(k *keeper.Keeper, ctx sdk.Context, store sdk.KVStore) {
+				moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+				keepertest.FundAccount(t, k, ctx, moduleAddr, sdk.NewCoins(sdk.NewInt64Coin("trycoin", 1)))
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx, storeKey := keepertest.NameserviceKeeper(t)
+			k.CreateWhois(ctx, types.MsgCreateWhois{Creator: owner, Name: "alpha.wallet", Address: owner, Price: "5trycoin"})
+			k.CreateWhois(ctx, types.MsgCreateWhois{Creator: owner, Name: "beta.wallet", Address: other, Price: "5trycoin"})
+			k.SetPrimaryName(ctx, owner, "alpha.wallet")
+			k.SetWhoisTransfer(ctx, types.WhoisTransfer{Id: "0", Name: "alpha.wallet", Owner: owner, Recipient: buyer})
+
+			msg, broken := tc.invariant(*k)(ctx)
+			require.False(t, broken, msg)
+
+			tc.corrupt(k, ctx, ctx.KVStore(storeKey))
+			_, broken = tc.invariant(*k)(ctx)
+			require.True(t, broken)
+			_, broken = keeper.AllInvariants(*k)(ctx)
+			require.True(t, broken)
+		})
+	}
+}
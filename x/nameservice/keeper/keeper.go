@@ -15,16 +15,17 @@ import (
 
 type (
 	Keeper struct {
-		CoinKeeper bank.Keeper
-		cdc        codec.Marshaler
-		storeKey   sdk.StoreKey
-		memKey     sdk.StoreKey
-		paramSpace paramtypes.Subspace
+		CoinKeeper  bank.Keeper
+		DistrKeeper types.DistributionKeeper
+		cdc         codec.Marshaler
+		storeKey    sdk.StoreKey
+		memKey      sdk.StoreKey
+		paramSpace  paramtypes.Subspace
 	}
 )
 
 func NewKeeper(
-	coinKeeper bank.Keeper, cdc codec.Marshaler,
+	coinKeeper bank.Keeper, distrKeeper types.DistributionKeeper, cdc codec.Marshaler,
 	storeKey, memKey sdk.StoreKey, paramSpace paramtypes.Subspace,
 ) *Keeper {
 
@@ -34,11 +35,12 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		CoinKeeper: coinKeeper,
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		paramSpace: paramSpace,
+		CoinKeeper:  coinKeeper,
+		DistrKeeper: distrKeeper,
+		cdc:         cdc,
+		storeKey:    storeKey,
+		memKey:      memKey,
+		paramSpace:  paramSpace,
 	}
 }
 
@@ -0,0 +1,427 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// legacyTldPrice is the TldPrice param before prices were typed coins
+type legacyTldPrice struct {
+	Tld   string `json:"tld"`
+	Price string `json:"price"`
+}
+
+// legacyLengthPrice is the LengthPrice param before prices were typed coins
+type legacyLengthPrice struct {
+	MaxLength uint64 `json:"max_length"`
+	Price     string `json:"price"`
+}
+
+// MigrateParamsToCoins converts the string prices of the params into sdk.Coins
+//
+// Params missing from the store are set to their default, prices that cannot be
+// parsed are replaced by the default price and logged.
+func (m Migrator) MigrateParamsToCoins(ctx sdk.Context) error {
+	k := m.keeper
+	cdc := codec.NewLegacyAmino()
+	params := types.DefaultParams()
+
+	prices := []struct {
+		key   []byte
+		price *sdk.Coins
+	}{
+		{types.KeyCreateWhoisPrice, &params.CreateWhoisPrice},
+		{types.KeyUpdateWhoisPrice, &params.UpdateWhoisPrice},
+		{types.KeyDeleteWhoisPrice, &params.DeleteWhoisPrice},
+		{types.KeyRenewWhoisPrice, &params.RenewWhoisPrice},
+	}
+	for _, p := range prices {
+		bz := k.paramSpace.GetRaw(ctx, p.key)
+		if bz == nil {
+			continue
+		}
+
+		// Keep prices that were already migrated
+		var coins sdk.Coins
+		if err := cdc.UnmarshalJSON(bz, &coins); err == nil {
+			*p.price = coins
+			continue
+		}
+
+		var price string
+		if err := cdc.UnmarshalJSON(bz, &price); err != nil {
+			return err
+		}
+		if coins, ok := m.parseLegacyPrice(ctx, string(p.key), price); ok {
+			*p.price = coins
+		}
+	}
+
+	if bz := k.paramSpace.GetRaw(ctx, types.KeyTldPrices); bz != nil && cdc.UnmarshalJSON(bz, &params.TldPrices) != nil {
+		var legacy []legacyTldPrice
+		if err := cdc.UnmarshalJSON(bz, &legacy); err != nil {
+			return err
+		}
+
+		params.TldPrices = []types.TldPrice{}
+		for _, tp := range legacy {
+			if coins, ok := m.parseLegacyPrice(ctx, "TldPrices/"+tp.Tld, tp.Price); ok {
+				params.TldPrices = append(params.TldPrices, types.TldPrice{Tld: tp.Tld, Price: coins})
+			}
+		}
+	}
+
+	if bz := k.paramSpace.GetRaw(ctx, types.KeyLengthPrices); bz != nil && cdc.UnmarshalJSON(bz, &params.LengthPrices) != nil {
+		var legacy []legacyLengthPrice
+		if err := cdc.UnmarshalJSON(bz, &legacy); err != nil {
+			return err
+		}
+
+		params.LengthPrices = []types.LengthPrice{}
+		for _, lp := range legacy {
+			if coins, ok := m.parseLegacyPrice(ctx, "LengthPrices", lp.Price); ok {
+				params.LengthPrices = append(params.LengthPrices, types.LengthPrice{MaxLength: lp.MaxLength, Price: coins})
+			}
+		}
+	}
+
+	// The remaining params did not change type
+	k.paramSpace.GetIfExists(ctx, types.KeyRegistrationPeriod, &params.RegistrationPeriod)
+	k.paramSpace.GetIfExists(ctx, types.KeyExpiryGracePeriod, &params.ExpiryGracePeriod)
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxExpirationsPerBlock, &params.MaxExpirationsPerBlock)
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeDestination, &params.FeeDestination)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+
+	return nil
+}
+
+// Migrate runs every nameservice migration in the order they depend on each other:
+//
+//  1. the params are converted to typed coins
+//  2. the store is moved to the version 2 layout, which the keeper reads from then on
+//  3. the default top level domains are added, names are registered under them
+//  4. the names are lowercased, which looks the whois up through the keeper
+//
+// Each migration leaves an already migrated state as it is, so Migrate can run on a
+// chain at any point of that sequence.
+func (m Migrator) Migrate(ctx sdk.Context) error {
+	for _, migrate := range []func(sdk.Context) error{
+		m.MigrateParamsToCoins,
+		m.Migrate1to2,
+		m.SeedDefaultTlds,
+		m.MigrateCanonicalNames,
+	} {
+		if err := migrate(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseLegacyPrice parses a price the way the string params were read before the migration
+func (m Migrator) parseLegacyPrice(ctx sdk.Context, param string, price string) (sdk.Coins, bool) {
+	coins, err := types.ParseCoins(price)
+	if err != nil || coins.Empty() {
+		m.keeper.Logger(ctx).Error("dropping invalid price param", "param", param, "price", price)
+		return nil, false
+	}
+
+	return coins, true
+}
+
+// SeedDefaultTlds adds the default top level domains missing from the store
+//
+// Chains started before top level domains were introduced have none, so no name could
+// be registered after the upgrade. Top level domains governance already set are kept
+// as they are, disabled ones included.
+func (m Migrator) SeedDefaultTlds(ctx sdk.Context) error {
+	k := m.keeper
+	for _, tld := range types.DefaultTlds() {
+		if _, found := k.GetTld(ctx, tld.Name); found {
+			continue
+		}
+		k.SetTld(ctx, *tld)
+	}
+
+	return nil
+}
+
+// MigrateCanonicalNames renames the top level domains and whois registered with uppercase
+// letters to their canonical lowercase form, rebuilding the indexes of the renamed whois.
+//
+// Among whois whose names only differ by case, the one registered first keeps the name
+// and the others are released. The store must be at the current layout version.
+func (m Migrator) MigrateCanonicalNames(ctx sdk.Context) error {
+	k := m.keeper
+
+	// The keeper only reads the current store layout, on an older one it would find nothing
+	if version := k.GetStoreVersion(ctx); version < types.ConsensusVersion {
+		return fmt.Errorf("store is at version %d, migrate it to version %d first", version, types.ConsensusVersion)
+	}
+
+	// Top level domains first, the names are looked up under them
+	tldStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TldKeyPrefix)
+	for _, tld := range k.GetAllTld(ctx) {
+		canonical := types.CanonicalName(tld.Name)
+		if canonical == tld.Name {
+			continue
+		}
+
+		tldStore.Delete([]byte(tld.Name))
+		if _, found := k.GetTld(ctx, canonical); found {
+			k.Logger(ctx).Info("dropped tld duplicating a canonical tld", "tld", tld.Name)
+			continue
+		}
+
+		tld.Name = canonical
+		k.SetTld(ctx, tld)
+	}
+
+	// Whois in order of registration
+	whoiss := k.GetAllWhois(ctx)
+	sort.Slice(whoiss, func(i, j int) bool {
+		a, _ := strconv.ParseUint(whoiss[i].Id, 10, 64)
+		b, _ := strconv.ParseUint(whoiss[j].Id, 10, 64)
+		return a < b
+	})
+
+	// The first whois registered under each canonical name keeps it, whatever its case
+	winners := make(map[string]string)
+	for _, whois := range whoiss {
+		canonical := types.CanonicalName(whois.Name)
+		if _, found := winners[canonical]; !found {
+			winners[canonical] = whois.Id
+		}
+	}
+
+	for _, whois := range whoiss {
+		canonical := types.CanonicalName(whois.Name)
+		if winners[canonical] != whois.Id {
+			k.DeleteWhois(ctx, whois.Id)
+			k.Logger(ctx).Info("released whois duplicating a canonical name", "id", whois.Id, "name", whois.Name)
+		}
+	}
+
+	for _, whois := range whoiss {
+		canonical := types.CanonicalName(whois.Name)
+		if canonical == whois.Name || winners[canonical] != whois.Id {
+			continue
+		}
+
+		// SetWhois voids the primary name and the pending transfer of a renamed whois, carry them over
+		primary, found := k.GetPrimaryName(ctx, whois.Address)
+		isPrimary := found && primary == whois.Name
+		transfer, hasTransfer := k.GetWhoisTransfer(ctx, whois.Id)
+
+		whois.Name = canonical
+		k.SetWhois(ctx, whois)
+
+		if isPrimary {
+			k.SetPrimaryName(ctx, whois.Address, canonical)
+		}
+		if hasTransfer {
+			transfer.Name = canonical
+			k.SetWhoisTransfer(ctx, transfer)
+		}
+	}
+
+	return nil
+}
+
+// Keys of the version 1 store layout, the whois records and their count were stored
+// under their prefix twice
+const (
+	legacyWhoisKey         = "Whois-value-Whois-value-"
+	legacyWhoisCountKey    = "Whois-count-Whois-count-"
+	legacyWhoisPrimaryKey  = "Whois-primary-"
+	legacyWhoisTransferKey = "WhoisTransfer-value-"
+	legacyTldKey           = "Tld-value-"
+	legacyFeeTotalsKey     = "FeeTotals-value-"
+)
+
+// Migrate1to2 moves the store from the string keys of version 1 to the byte prefixed
+// layout of version 2 documented in types/keys.go
+//
+// The records are read out of the old layout, every old key is deleted and the records
+// are written back with the keeper, which rebuilds the secondary indexes. Of whois sharing
+// a name only the first registered is kept, and primary names and transfers that no longer
+// match their whois are dropped. Whois without an expiry height start a registration
+// period at the upgrade height and whois without a revision start at revision 1. Stores
+// already at version 2 are left untouched.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+	if k.GetStoreVersion(ctx) >= 2 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	var whoiss []types.Whois
+	if err := m.iterateLegacy(ctx, legacyWhoisKey, func(_ string, value []byte) error {
+		var whois types.Whois
+		if err := k.cdc.UnmarshalBinaryBare(value, &whois); err != nil {
+			return err
+		}
+		whoiss = append(whoiss, whois)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	var count int64
+	if bz := store.Get([]byte(legacyWhoisCountKey)); bz != nil {
+		var err error
+		if count, err = strconv.ParseInt(string(bz), 10, 64); err != nil {
+			return fmt.Errorf("cannot decode whois count %q: %w", bz, err)
+		}
+	}
+
+	primaryNames := make(map[string]string)
+	if err := m.iterateLegacy(ctx, legacyWhoisPrimaryKey, func(address string, value []byte) error {
+		primaryNames[address] = string(value)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	var transfers []types.WhoisTransfer
+	if err := m.iterateLegacy(ctx, legacyWhoisTransferKey, func(_ string, value []byte) error {
+		var transfer types.WhoisTransfer
+		if err := k.cdc.UnmarshalBinaryBare(value, &transfer); err != nil {
+			return err
+		}
+		transfers = append(transfers, transfer)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	var tlds []types.Tld
+	if err := m.iterateLegacy(ctx, legacyTldKey, func(_ string, value []byte) error {
+		var tld types.Tld
+		if err := k.cdc.UnmarshalBinaryBare(value, &tld); err != nil {
+			return err
+		}
+		tlds = append(tlds, tld)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	var totals *types.FeeTotals
+	if bz := store.Get([]byte(legacyFeeTotalsKey)); bz != nil {
+		totals = &types.FeeTotals{}
+		if err := k.cdc.UnmarshalBinaryBare(bz, totals); err != nil {
+			return err
+		}
+	}
+
+	// Every key of the store belongs to the old layout, the indexes are rebuilt below
+	var keys [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, tld := range tlds {
+		k.SetTld(ctx, tld)
+	}
+
+	for _, whois := range whoiss {
+		if !types.IsWhoisId(whois.Id) {
+			return fmt.Errorf("whois %s has an invalid id %s", whois.Name, whois.Id)
+		}
+	}
+
+	// Version 1 genesis didn't reject duplicated names, the first registration keeps the name
+	sort.Slice(whoiss, func(i, j int) bool {
+		a, _ := strconv.ParseUint(whoiss[i].Id, 10, 64)
+		b, _ := strconv.ParseUint(whoiss[j].Id, 10, 64)
+		return a < b
+	})
+	period := k.RegistrationPeriod(ctx)
+	for _, whois := range whoiss {
+		if k.IsNamePresent(ctx, whois.Name) {
+			k.Logger(ctx).Info("released whois duplicating a name", "id", whois.Id, "name", whois.Name)
+			continue
+		}
+
+		// Names registered before expiry was introduced start their first period now
+		if whois.Expires == 0 && period > 0 {
+			whois.Expires = ctx.BlockHeight() + period
+		}
+		// Revisions start at 1, records written before revisions were introduced have none
+		if whois.Revision == 0 {
+			whois.Revision = 1
+		}
+		k.SetWhois(ctx, whois)
+	}
+	k.SetWhoisCount(ctx, count)
+
+	for address, name := range primaryNames {
+		if whois, found := k.GetWhoisByName(ctx, name); found && whois.Address == address {
+			k.SetPrimaryName(ctx, address, name)
+		} else {
+			k.Logger(ctx).Info("dropped stale primary name", "address", address, "name", name)
+		}
+	}
+
+	for _, transfer := range transfers {
+		if whois, found := k.GetWhois(ctx, transfer.Id); found && whois.Name == transfer.Name && whois.Creator == transfer.Owner {
+			k.SetWhoisTransfer(ctx, transfer)
+		} else {
+			k.Logger(ctx).Info("dropped stale whois transfer", "id", transfer.Id, "name", transfer.Name)
+		}
+	}
+
+	if totals != nil {
+		k.SetFeeTotals(ctx, *totals)
+	}
+
+	k.SetStoreVersion(ctx, 2)
+
+	return nil
+}
+
+// iterateLegacy calls cb with the key, without its prefix, and the value of every entry
+// of the version 1 layout stored under keyPrefix
+func (m Migrator) iterateLegacy(ctx sdk.Context, keyPrefix string, cb func(key string, value []byte) error) error {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(m.keeper.storeKey), []byte(keyPrefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if err := cb(string(iterator.Key()[len(keyPrefix):]), iterator.Value()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
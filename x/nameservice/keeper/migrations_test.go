@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/enqack/nameservice/testutil/keeper"
+	"github.com/enqack/nameservice/x/nameservice/keeper"
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+func TestMigrateCanonicalNames(t *testing.T) {
+	k, ctx, _ := keepertest.NameserviceKeeper(t)
+	other := sdk.AccAddress("other_______________").String()
+
+	// Names registered before they had to be lowercase
+	for _, whois := range []types.Whois{
+		{Id: "0", Name: "Alice.wallet", Creator: owner, Address: owner, Price: "5trycoin"},
+		{Id: "1", Name: "alice.wallet", Creator: other, Address: other, Price: "5trycoin"},
+		{Id: "2", Name: "bob.wallet", Creator: other, Address: other, Price: "5trycoin"},
+		{Id: "3", Name: "BOB.wallet", Creator: owner, Address: owner, Price: "5trycoin"},
+		{Id: "4", Name: "Carol.Wallet", Creator: owner, Address: owner, Price: "5trycoin"},
+	} {
+		k.SetWhois(ctx, whois)
+	}
+	k.SetWhoisCount(ctx, 5)
+	k.SetPrimaryName(ctx, owner, "Carol.Wallet")
+	k.SetWhoisTransfer(ctx, types.WhoisTransfer{Id: "4", Name: "Carol.Wallet", Owner: owner, Recipient: other})
+
+	require.NoError(t, keeper.NewMigrator(*k).MigrateCanonicalNames(ctx))
+
+	// The first registration of each name keeps it
+	for name, id := range map[string]string{"alice.wallet": "0", "bob.wallet": "2", "carol.wallet": "4"} {
+		whois, found := k.GetWhoisByName(ctx, name)
+		require.True(t, found, name)
+		require.Equal(t, id, whois.Id, name)
+		require.Equal(t, name, whois.Name)
+	}
+	for _, id := range []string{"1", "3"} {
+		require.False(t, k.HasWhois(ctx, id), "whois %s", id)
+	}
+
+	primary, found := k.GetPrimaryName(ctx, owner)
+	require.True(t, found)
+	require.Equal(t, "carol.wallet", primary)
+	transfer, found := k.GetWhoisTransfer(ctx, "4")
+	require.True(t, found)
+	require.Equal(t, "carol.wallet", transfer.Name)
+
+	msg, broken := keeper.AllInvariants(*k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestMigrateCanonicalNamesRequiresCurrentLayout(t *testing.T) {
+	k, ctx, _ := keepertest.NameserviceKeeper(t)
+	k.SetStoreVersion(ctx, 1)
+
+	require.Error(t, keeper.NewMigrator(*k).MigrateCanonicalNames(ctx))
+}
+
+// writeV1Store replaces the content of the store with a snapshot in the version 1 layout
+func writeV1Store(t *testing.T, store sdk.KVStore, whoiss []types.Whois, set func(key string, value []byte)) {
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	require.NoError(t, iterator.Close())
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	for _, whois := range whoiss {
+		whois := whois
+		set("Whois-value-Whois-value-"+whois.Id, cdc.MustMarshalBinaryBare(&whois))
+		set("Whois-name-"+whois.Name, []byte(whois.Id))
+		set("Whois-address-"+whois.Address+"/"+whois.Name, []byte(whois.Id))
+		set("Whois-owner-"+whois.Creator+"/"+whois.Name, []byte(whois.Id))
+		if whois.Expires > 0 {
+			set("Whois-expiry-"+string(sdk.Uint64ToBigEndian(uint64(whois.Expires)))+whois.Id, []byte(whois.Id))
+		}
+	}
+}
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx, storeKey := keepertest.NameserviceKeeper(t)
+	store := ctx.KVStore(storeKey)
+	set := func(key string, value []byte) { store.Set([]byte(key), value) }
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	other := sdk.AccAddress("other_______________").String()
+
+	whoiss := []types.Whois{
+		{Id: "0", Name: "alpha.wallet", Creator: owner, Address: owner, Price: "5trycoin", Expires: 500, Revision: 3},
+		{Id: "1", Name: "beta.wallet", Creator: other, Address: other, Price: "5trycoin"},
+		// Duplicates the name of whois 0, version 1 genesis let it through
+		{Id: "2", Name: "alpha.wallet", Creator: other, Address: other, Price: "5trycoin"},
+		{Id: "10", Name: "gamma.wallet", Creator: owner, Address: other, Price: "5trycoin", Expires: 100},
+	}
+	writeV1Store(t, store, whoiss, set)
+	set("Whois-count-Whois-count-", []byte("11"))
+	set("Whois-primary-"+owner, []byte("alpha.wallet"))
+	set("Whois-primary-"+other, []byte("stale.wallet"))
+	for _, transfer := range []types.WhoisTransfer{
+		{Id: "1", Name: "beta.wallet", Owner: other, Recipient: owner},
+		{Id: "2", Name: "alpha.wallet", Owner: other, Recipient: owner},
+	} {
+		transfer := transfer
+		set("WhoisTransfer-value-"+transfer.Id, cdc.MustMarshalBinaryBare(&transfer))
+	}
+	tld := types.Tld{Name: "wallet", Enabled: true}
+	set("Tld-value-wallet", cdc.MustMarshalBinaryBare(&tld))
+	totals := types.FeeTotals{Burned: sdk.NewCoins(sdk.NewInt64Coin("trycoin", 42))}
+	set("FeeTotals-value-", cdc.MustMarshalBinaryBare(&totals))
+
+	require.EqualValues(t, 1, k.GetStoreVersion(ctx))
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+	require.EqualValues(t, types.ConsensusVersion, k.GetStoreVersion(ctx))
+
+	// No key of the old layout is left
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		require.Less(t, iterator.Key()[0], byte('A'), "key %q", iterator.Key())
+	}
+	require.NoError(t, iterator.Close())
+
+	// Records come back in id order, the duplicated name stays with the first registration
+	var ids []string
+	for _, whois := range k.GetAllWhois(ctx) {
+		ids = append(ids, whois.Id)
+	}
+	require.Equal(t, []string{"0", "1", "10"}, ids)
+	alpha, found := k.GetWhoisByName(ctx, "alpha.wallet")
+	require.True(t, found)
+	require.Equal(t, whoiss[0], alpha)
+	require.EqualValues(t, 11, k.GetWhoisCount(ctx))
+
+	// Records written before expiry and revisions start a registration period at revision 1,
+	// alpha above kept its own
+	beta, found := k.GetWhoisByName(ctx, "beta.wallet")
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight()+k.RegistrationPeriod(ctx), beta.Expires)
+	require.EqualValues(t, 1, beta.Revision)
+
+	primary, found := k.GetPrimaryName(ctx, owner)
+	require.True(t, found)
+	require.Equal(t, "alpha.wallet", primary)
+	_, found = k.GetPrimaryName(ctx, other)
+	require.False(t, found)
+
+	transfers := k.GetAllWhoisTransfer(ctx)
+	require.Len(t, transfers, 1)
+	require.Equal(t, "beta.wallet", transfers[0].Name)
+
+	require.True(t, k.IsTldEnabled(ctx, "wallet"))
+	require.Equal(t, totals, k.GetFeeTotals(ctx))
+
+	msg, broken := keeper.AllInvariants(*k)(ctx)
+	require.False(t, broken, msg)
+
+	// A new whois takes the next id
+	whois := k.CreateWhois(ctx, types.MsgCreateWhois{Creator: owner, Name: "delta.wallet", Address: owner, Price: "5trycoin"})
+	require.Equal(t, "11", whois.Id)
+}
+
+func TestMigrate(t *testing.T) {
+	k, ctx, storeKey := keepertest.NameserviceKeeper(t)
+	store := ctx.KVStore(storeKey)
+	set := func(key string, value []byte) { store.Set([]byte(key), value) }
+
+	// A chain started before top level domains and lowercase names
+	writeV1Store(t, store, []types.Whois{
+		{Id: "0", Name: "Alpha.wallet", Creator: owner, Address: owner, Price: "5trycoin"},
+		{Id: "1", Name: "alpha.wallet", Creator: owner, Address: owner, Price: "5trycoin"},
+	}, set)
+	set("Whois-count-Whois-count-", []byte("2"))
+
+	migrator := keeper.NewMigrator(*k)
+	require.NoError(t, migrator.Migrate(ctx))
+
+	alpha, found := k.GetWhoisByName(ctx, "alpha.wallet")
+	require.True(t, found)
+	require.Equal(t, "0", alpha.Id)
+	require.False(t, k.HasWhois(ctx, "1"))
+	for _, tld := range types.DefaultTlds() {
+		require.True(t, k.IsTldEnabled(ctx, tld.Name), tld.Name)
+	}
+
+	msg, broken := keeper.AllInvariants(*k)(ctx)
+	require.False(t, broken, msg)
+
+	// Running it again changes nothing
+	before := k.GetAllWhois(ctx)
+	require.NoError(t, migrator.Migrate(ctx))
+	require.Equal(t, before, k.GetAllWhois(ctx))
+}
+
+func TestSeedDefaultTldsKeepsGovernanceChanges(t *testing.T) {
+	k, ctx, _ := keepertest.NameserviceKeeper(t)
+	k.SetTld(ctx, types.Tld{Name: "wallet", Enabled: false})
+
+	require.NoError(t, keeper.NewMigrator(*k).SeedDefaultTlds(ctx))
+
+	require.False(t, k.IsTldEnabled(ctx, "wallet"))
+	require.True(t, k.IsTldEnabled(ctx, "contract"))
+	require.True(t, k.IsTldEnabled(ctx, "validator"))
+}
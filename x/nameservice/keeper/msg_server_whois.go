@@ -0,0 +1,495 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+func (k msgServer) CreateWhois(goCtx context.Context, msg *types.MsgCreateWhois) (*types.MsgCreateWhoisResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if whois name already exists
+	if k.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrapf(types.ErrNameTaken, "name %s", msg.Name)
+	}
+
+	// Check is name is valid
+	if err := k.ValidateNameFormat(ctx, msg.Name); err != nil {
+		return nil, err
+	}
+
+	// Convert creator (type string) to sdk.AccAddress type
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the registration cost of the name
+	registrationCost := k.GetRegistrationCost(ctx, msg.Name)
+
+	// Charge the registration fee to the creator
+	err = k.ChargeFee(ctx, creator, registrationCost)
+	if err != nil {
+		return nil, err
+	}
+
+	whois := k.Keeper.CreateWhois(ctx, *msg)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWhoisCreated{
+		Id:      whois.Id,
+		Name:    whois.Name,
+		Owner:   whois.Creator,
+		Address: whois.Address,
+		Price:   whois.Price,
+		Expires: whois.Expires,
+		Fee:     registrationCost,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateWhoisResponse{Id: whois.Id}, nil
+}
+
+func (k msgServer) UpdateWhois(goCtx context.Context, msg *types.MsgUpdateWhois) (*types.MsgUpdateWhoisResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check that the element exists
+	current, err := k.getWhoisByNameOrId(ctx, msg.CurrentName, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the the msg sender is the same as the current owner
+	if msg.Creator != current.Creator {
+		return nil, types.ErrNotOwner
+	}
+
+	// Lapsed registrations can only be renewed
+	if k.IsExpired(ctx, current) {
+		return nil, sdkerrors.Wrapf(types.ErrNameExpired, "name %s", current.Name)
+	}
+
+	// Check that the whois didn't change since the client read it
+	if msg.ExpectedRevision != 0 && msg.ExpectedRevision != current.Revision {
+		return nil, sdkerrors.Wrapf(types.ErrRevisionMismatch, "expected revision %d, whois %s is at revision %d", msg.ExpectedRevision, current.Id, current.Revision)
+	}
+
+	// Only the fields of the update mask change
+	whois := current
+	paths := msg.UpdatePaths()
+
+	if paths[types.UpdateMaskName] && msg.Name != current.Name {
+		// Check if whois name already exists
+		if k.IsNamePresent(ctx, msg.Name) {
+			return nil, sdkerrors.Wrapf(types.ErrNameTaken, "name %s", msg.Name)
+		}
+
+		// Check if name is valid
+		if err := k.ValidateNameFormat(ctx, msg.Name); err != nil {
+			return nil, err
+		}
+
+		whois.Name = msg.Name
+	}
+
+	if paths[types.UpdateMaskAddress] {
+		whois.Address = msg.Address
+	}
+
+	if paths[types.UpdateMaskPrice] {
+		whois.Price = msg.Price
+	}
+
+	// Convert creator (type string) to sdk.AccAddress type
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get update-whois price
+	updateWhoisPrice := k.UpdateWhoisPrice(ctx)
+
+	// A new name is registered like any other, renaming would otherwise get short and
+	// premium names for the price of an update
+	if whois.Name != current.Name {
+		updateWhoisPrice = updateWhoisPrice.Add(k.GetRegistrationCost(ctx, whois.Name)...)
+	}
+
+	// Charge the fee to the owner
+	err = k.ChargeFee(ctx, creator, updateWhoisPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	whois = k.SetWhois(ctx, whois)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWhoisUpdated{
+		Id:         whois.Id,
+		Owner:      whois.Creator,
+		OldName:    current.Name,
+		NewName:    whois.Name,
+		OldAddress: current.Address,
+		NewAddress: whois.Address,
+		OldPrice:   current.Price,
+		NewPrice:   whois.Price,
+		Fee:        updateWhoisPrice,
+		Revision:   whois.Revision,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateWhoisResponse{Revision: whois.Revision}, nil
+}
+
+func (k msgServer) DeleteWhois(goCtx context.Context, msg *types.MsgDeleteWhois) (*types.MsgDeleteWhoisResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check that the element exists
+	whois, err := k.getWhoisByNameOrId(ctx, msg.Name, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the the msg sender is the same as the current owner
+	if msg.Creator != whois.Creator {
+		return nil, types.ErrNotOwner
+	}
+
+	// Convert creator (type string) to sdk.AccAddress type
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get delete-whois price
+	deleteWhoisPrice := k.DeleteWhoisPrice(ctx)
+
+	// Charge the fee to the owner
+	err = k.ChargeFee(ctx, creator, deleteWhoisPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Keeper.DeleteWhois(ctx, whois.Id)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWhoisDeleted{
+		Id:      whois.Id,
+		Name:    whois.Name,
+		Owner:   whois.Creator,
+		Address: whois.Address,
+		Fee:     deleteWhoisPrice,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteWhoisResponse{}, nil
+}
+
+func (k msgServer) SetPrimaryName(goCtx context.Context, msg *types.MsgSetPrimaryName) (*types.MsgSetPrimaryNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check that the name exists
+	whois, found := k.GetWhoisByName(ctx, msg.Name)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNameNotFound, "name %s doesn't exist", msg.Name)
+	}
+
+	// Only the address the name resolves to can make it its primary name, the owner of
+	// the name could otherwise attach a name to any address
+	if msg.Creator != whois.Address {
+		return nil, sdkerrors.Wrapf(types.ErrNotNameAddress, "name %s resolves to %s", whois.Name, whois.Address)
+	}
+
+	k.Keeper.SetPrimaryName(ctx, whois.Address, whois.Name)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventPrimaryNameSet{
+		Name:    whois.Name,
+		Owner:   whois.Creator,
+		Address: whois.Address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPrimaryNameResponse{}, nil
+}
+
+func (k msgServer) RenewWhois(goCtx context.Context, msg *types.MsgRenewWhois) (*types.MsgRenewWhoisResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check that the name exists
+	whois, found := k.GetWhoisByName(ctx, msg.Name)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNameNotFound, "name %s doesn't exist", msg.Name)
+	}
+
+	// Check if the the msg sender is the same as the current owner
+	if msg.Creator != whois.Creator {
+		return nil, types.ErrNotOwner
+	}
+
+	// Check that registrations expire at all
+	if whois.Expires == 0 || k.RegistrationPeriod(ctx) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrNoExpiry, "name %s", whois.Name)
+	}
+
+	// Convert creator (type string) to sdk.AccAddress type
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get renew-whois price
+	renewWhoisPrice := k.RenewWhoisPrice(ctx)
+
+	// Charge the fee to the owner
+	err = k.ChargeFee(ctx, creator, renewWhoisPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	whois = k.Keeper.RenewWhois(ctx, whois)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWhoisRenewed{
+		Id:      whois.Id,
+		Name:    whois.Name,
+		Owner:   whois.Creator,
+		Expires: whois.Expires,
+		Fee:     renewWhoisPrice,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewWhoisResponse{Expires: whois.Expires}, nil
+}
+
+func (k msgServer) BuyWhois(goCtx context.Context, msg *types.MsgBuyWhois) (*types.MsgBuyWhoisResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check that the name exists
+	whois, found := k.GetWhoisByName(ctx, msg.Name)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNameNotFound, "name %s doesn't exist", msg.Name)
+	}
+
+	// Lapsed registrations can only be renewed by their owner
+	if k.IsExpired(ctx, whois) {
+		return nil, sdkerrors.Wrapf(types.ErrNameExpired, "name %s", whois.Name)
+	}
+
+	// Check that the owner is selling
+	if !whois.ForSale {
+		return nil, sdkerrors.Wrapf(types.ErrNotForSale, "name %s", whois.Name)
+	}
+
+	if msg.Buyer == whois.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyOwner, "buyer %s", msg.Buyer)
+	}
+
+	// Convert buyer and owner (type string) to sdk.AccAddress type
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromBech32(whois.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the listed price is within what the buyer is willing to pay
+	price, err := types.ParseCoins(whois.Price)
+	if err != nil {
+		return nil, err
+	}
+	// A name without a price can't be sold, it would change hands for nothing
+	if price.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrNotForSale, "name %s has no price", whois.Name)
+	}
+	maxPrice, err := types.ParseCoins(msg.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+	if !maxPrice.IsAllGTE(price) {
+		return nil, sdkerrors.Wrapf(types.ErrPriceExceeded, "price %s exceeds max price %s", price, maxPrice)
+	}
+
+	// Pay the owner
+	err = k.CoinKeeper.SendCoins(ctx, buyer, owner, price)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hand the name over, the new owner decides when to list it again
+	whois.Creator = msg.Buyer
+	whois.ForSale = false
+	k.SetWhois(ctx, whois)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWhoisBought{
+		Id:     whois.Id,
+		Name:   whois.Name,
+		Seller: owner.String(),
+		Buyer:  buyer.String(),
+		Price:  price,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBuyWhoisResponse{}, nil
+}
+
+func (k msgServer) SetWhoisForSale(goCtx context.Context, msg *types.MsgSetWhoisForSale) (*types.MsgSetWhoisForSaleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check that the name exists
+	whois, found := k.GetWhoisByName(ctx, msg.Name)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNameNotFound, "name %s doesn't exist", msg.Name)
+	}
+
+	// Check if the the msg sender is the same as the current owner
+	if msg.Creator != whois.Creator {
+		return nil, types.ErrNotOwner
+	}
+
+	whois.ForSale = msg.ForSale
+	k.SetWhois(ctx, whois)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventWhoisForSaleSet{
+		Id:      whois.Id,
+		Name:    whois.Name,
+		Owner:   whois.Creator,
+		ForSale: whois.ForSale,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetWhoisForSaleResponse{}, nil
+}
+
+func (k msgServer) TransferWhois(goCtx context.Context, msg *types.MsgTransferWhois) (*types.MsgTransferWhoisResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check that the name exists
+	whois, found := k.GetWhoisByName(ctx, msg.Name)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNameNotFound, "name %s doesn't exist", msg.Name)
+	}
+
+	// Check if the the msg sender is the same as the current owner
+	if msg.Creator != whois.Creator {
+		return nil, types.ErrNotOwner
+	}
+
+	// Lapsed registrations can only be renewed
+	if k.IsExpired(ctx, whois) {
+		return nil, sdkerrors.Wrapf(types.ErrNameExpired, "name %s", whois.Name)
+	}
+
+	if msg.Recipient == whois.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyOwner, "recipient %s", msg.Recipient)
+	}
+
+	k.SetWhoisTransfer(ctx, types.WhoisTransfer{
+		Id:        whois.Id,
+		Name:      whois.Name,
+		Owner:     whois.Creator,
+		Recipient: msg.Recipient,
+	})
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventWhoisTransferOffered{
+		Id:        whois.Id,
+		Name:      whois.Name,
+		Owner:     whois.Creator,
+		Recipient: msg.Recipient,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferWhoisResponse{}, nil
+}
+
+func (k msgServer) AcceptWhoisTransfer(goCtx context.Context, msg *types.MsgAcceptWhoisTransfer) (*types.MsgAcceptWhoisTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check that the name exists
+	whois, found := k.GetWhoisByName(ctx, msg.Name)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNameNotFound, "name %s doesn't exist", msg.Name)
+	}
+
+	// Check that a transfer to the msg sender is pending
+	transfer, found := k.GetWhoisTransfer(ctx, whois.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTransferNotFound, "name %s", msg.Name)
+	}
+	if msg.Creator != transfer.Recipient {
+		return nil, types.ErrNotRecipient
+	}
+
+	// Lapsed registrations can only be renewed
+	if k.IsExpired(ctx, whois) {
+		return nil, sdkerrors.Wrapf(types.ErrNameExpired, "name %s", whois.Name)
+	}
+
+	// Hand the name over, SetWhois moves the owner index and voids the transfer
+	whois.Creator = transfer.Recipient
+	whois.ForSale = false
+	k.SetWhois(ctx, whois)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventWhoisTransferAccepted{
+		Id:        whois.Id,
+		Name:      whois.Name,
+		Owner:     transfer.Owner,
+		Recipient: transfer.Recipient,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptWhoisTransferResponse{}, nil
+}
+
+func (k msgServer) CancelWhoisTransfer(goCtx context.Context, msg *types.MsgCancelWhoisTransfer) (*types.MsgCancelWhoisTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check that the name exists
+	whois, found := k.GetWhoisByName(ctx, msg.Name)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNameNotFound, "name %s doesn't exist", msg.Name)
+	}
+
+	// Check if the the msg sender is the same as the current owner
+	if msg.Creator != whois.Creator {
+		return nil, types.ErrNotOwner
+	}
+
+	transfer, found := k.GetWhoisTransfer(ctx, whois.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTransferNotFound, "name %s", msg.Name)
+	}
+
+	k.DeleteWhoisTransfer(ctx, whois.Id)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventWhoisTransferCancelled{
+		Id:        whois.Id,
+		Name:      whois.Name,
+		Owner:     whois.Creator,
+		Recipient: transfer.Recipient,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelWhoisTransferResponse{}, nil
+}
@@ -0,0 +1,334 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/enqack/nameservice/testutil/keeper"
+	"github.com/enqack/nameservice/x/nameservice/keeper"
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+var buyer = sdk.AccAddress("buyer_______________").String()
+
+// setupMsgServer returns a msg server with the default top level domains enabled and
+// owner and buyer funded with 1000trycoin
+func setupMsgServer(t *testing.T) (types.MsgServer, *keeper.Keeper, sdk.Context, context.Context) {
+	k, ctx, _ := keepertest.NameserviceKeeper(t)
+	for _, tld := range types.DefaultTlds() {
+		k.SetTld(ctx, *tld)
+	}
+	for _, addr := range []string{owner, buyer} {
+		keepertest.FundAccount(t, k, ctx, mustAddress(addr), sdk.NewCoins(sdk.NewInt64Coin("trycoin", 1000)))
+	}
+	return keeper.NewMsgServerImpl(*k), k, ctx, sdk.WrapSDKContext(ctx)
+}
+
+func mustAddress(addr string) sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		panic(err)
+	}
+	return acc
+}
+
+// balance returns the trycoin balance of addr
+func balance(k *keeper.Keeper, ctx sdk.Context, addr string) int64 {
+	return k.CoinKeeper.GetBalance(ctx, mustAddress(addr), "trycoin").Amount.Int64()
+}
+
+func TestMsgServerBuyWhois(t *testing.T) {
+	srv, k, ctx, goCtx := setupMsgServer(t)
+	_, err := srv.CreateWhois(goCtx, &types.MsgCreateWhois{Creator: owner, Name: "alpha.wallet", Address: owner, Price: "50trycoin"})
+	require.NoError(t, err)
+
+	// New names aren't for sale until listed
+	_, err = srv.BuyWhois(goCtx, &types.MsgBuyWhois{Buyer: buyer, Name: "alpha.wallet", MaxPrice: "100trycoin"})
+	require.ErrorIs(t, err, types.ErrNotForSale)
+
+	_, err = srv.SetWhoisForSale(goCtx, &types.MsgSetWhoisForSale{Creator: owner, Name: "alpha.wallet", ForSale: true})
+	require.NoError(t, err)
+
+	_, err = srv.BuyWhois(goCtx, &types.MsgBuyWhois{Buyer: buyer, Name: "alpha.wallet", MaxPrice: "49trycoin"})
+	require.ErrorIs(t, err, types.ErrPriceExceeded)
+	_, err = srv.BuyWhois(goCtx, &types.MsgBuyWhois{Buyer: owner, Name: "alpha.wallet", MaxPrice: "50trycoin"})
+	require.ErrorIs(t, err, types.ErrAlreadyOwner)
+
+	ownerBalance, buyerBalance := balance(k, ctx, owner), balance(k, ctx, buyer)
+	_, err = srv.BuyWhois(goCtx, &types.MsgBuyWhois{Buyer: buyer, Name: "alpha.wallet", MaxPrice: "50trycoin"})
+	require.NoError(t, err)
+	require.Equal(t, ownerBalance+50, balance(k, ctx, owner))
+	require.Equal(t, buyerBalance-50, balance(k, ctx, buyer))
+
+	whois, found := k.GetWhoisByName(ctx, "alpha.wallet")
+	require.True(t, found)
+	require.Equal(t, buyer, whois.Creator)
+	require.False(t, whois.ForSale)
+
+	msg, broken := keeper.AllInvariants(*k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestMsgServerBuyWhoisWithoutPrice(t *testing.T) {
+	srv, k, ctx, goCtx := setupMsgServer(t)
+	// Genesis and older versions let names be listed without a price
+	whois := k.CreateWhois(ctx, types.MsgCreateWhois{Creator: owner, Name: "alpha.wallet", Address: owner})
+	whois.ForSale = true
+	k.SetWhois(ctx, whois)
+
+	_, err := srv.BuyWhois(goCtx, &types.MsgBuyWhois{Buyer: buyer, Name: "alpha.wallet", MaxPrice: "50trycoin"})
+	require.ErrorIs(t, err, types.ErrNotForSale)
+
+	whois, _ = k.GetWhoisByName(ctx, "alpha.wallet")
+	require.Equal(t, owner, whois.Creator)
+}
+
+func TestMsgServerWhoisTransfer(t *testing.T) {
+	srv, k, ctx, goCtx := setupMsgServer(t)
+	_, err := srv.CreateWhois(goCtx, &types.MsgCreateWhois{Creator: owner, Name: "alpha.wallet", Address: owner, Price: "50trycoin"})
+	require.NoError(t, err)
+
+	_, err = srv.AcceptWhoisTransfer(goCtx, &types.MsgAcceptWhoisTransfer{Creator: buyer, Name: "alpha.wallet"})
+	require.ErrorIs(t, err, types.ErrTransferNotFound)
+	_, err = srv.TransferWhois(goCtx, &types.MsgTransferWhois{Creator: buyer, Name: "alpha.wallet", Recipient: buyer})
+	require.ErrorIs(t, err, types.ErrNotOwner)
+	_, err = srv.TransferWhois(goCtx, &types.MsgTransferWhois{Creator: owner, Name: "alpha.wallet", Recipient: owner})
+	require.ErrorIs(t, err, types.ErrAlreadyOwner)
+
+	// A cancelled offer can't be accepted
+	_, err = srv.TransferWhois(goCtx, &types.MsgTransferWhois{Creator: owner, Name: "alpha.wallet", Recipient: buyer})
+	require.NoError(t, err)
+	_, err = srv.CancelWhoisTransfer(goCtx, &types.MsgCancelWhoisTransfer{Creator: buyer, Name: "alpha.wallet"})
+	require.ErrorIs(t, err, types.ErrNotOwner)
+	_, err = srv.CancelWhoisTransfer(goCtx, &types.MsgCancelWhoisTransfer{Creator: owner, Name: "alpha.wallet"})
+	require.NoError(t, err)
+	_, err = srv.AcceptWhoisTransfer(goCtx, &types.MsgAcceptWhoisTransfer{Creator: buyer, Name: "alpha.wallet"})
+	require.ErrorIs(t, err, types.ErrTransferNotFound)
+
+	// Only the recipient accepts, and gets the name without paying for it
+	_, err = srv.TransferWhois(goCtx, &types.MsgTransferWhois{Creator: owner, Name: "alpha.wallet", Recipient: buyer})
+	require.NoError(t, err)
+	_, err = srv.AcceptWhoisTransfer(goCtx, &types.MsgAcceptWhoisTransfer{Creator: owner, Name: "alpha.wallet"})
+	require.ErrorIs(t, err, types.ErrNotRecipient)
+	buyerBalance := balance(k, ctx, buyer)
+	_, err = srv.AcceptWhoisTransfer(goCtx, &types.MsgAcceptWhoisTransfer{Creator: buyer, Name: "alpha.wallet"})
+	require.NoError(t, err)
+	require.Equal(t, buyerBalance, balance(k, ctx, buyer))
+
+	whois, _ := k.GetWhoisByName(ctx, "alpha.wallet")
+	require.Equal(t, buyer, whois.Creator)
+	_, found := k.GetWhoisTransfer(ctx, whois.Id)
+	require.False(t, found)
+
+	msg, broken := keeper.AllInvariants(*k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestMsgServerWhoisTransferVoidedBySale(t *testing.T) {
+	srv, k, ctx, goCtx := setupMsgServer(t)
+	recipient := sdk.AccAddress("recipient___________").String()
+	_, err := srv.CreateWhois(goCtx, &types.MsgCreateWhois{Creator: owner, Name: "alpha.wallet", Address: owner, Price: "50trycoin"})
+	require.NoError(t, err)
+	_, err = srv.TransferWhois(goCtx, &types.MsgTransferWhois{Creator: owner, Name: "alpha.wallet", Recipient: recipient})
+	require.NoError(t, err)
+
+	_, err = srv.SetWhoisForSale(goCtx, &types.MsgSetWhoisForSale{Creator: owner, Name: "alpha.wallet", ForSale: true})
+	require.NoError(t, err)
+	_, err = srv.BuyWhois(goCtx, &types.MsgBuyWhois{Buyer: buyer, Name: "alpha.wallet", MaxPrice: "50trycoin"})
+	require.NoError(t, err)
+
+	// The offer of the previous owner doesn't bind the buyer
+	_, err = srv.AcceptWhoisTransfer(goCtx, &types.MsgAcceptWhoisTransfer{Creator: recipient, Name: "alpha.wallet"})
+	require.ErrorIs(t, err, types.ErrTransferNotFound)
+	whois, _ := k.GetWhoisByName(ctx, "alpha.wallet")
+	require.Equal(t, buyer, whois.Creator)
+
+	msg, broken := keeper.AllInvariants(*k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestMsgServerRegistrationCost(t *testing.T) {
+	srv, k, ctx, goCtx := setupMsgServer(t)
+	params := types.DefaultParams()
+	params.TldPrices = []types.TldPrice{{Tld: "contract", Price: sdk.NewCoins(sdk.NewInt64Coin("trycoin", 30))}}
+	k.SetParams(ctx, params)
+
+	for _, tc := range []struct {
+		name string
+		cost int64
+	}{
+		// CreateWhoisPrice of 10, the 100 premium of labels up to 3 characters
+		{name: "abc.wallet", cost: 110},
+		// The 20 premium of labels up to 5 characters, the tightest tier applies
+		{name: "abcd.wallet", cost: 30},
+		{name: "abcdef.wallet", cost: 10},
+		// The TLD price replaces CreateWhoisPrice
+		{name: "abcdef.contract", cost: 30},
+		{name: "abc.contract", cost: 130},
+	} {
+		ownerBalance := balance(k, ctx, owner)
+		_, err := srv.CreateWhois(goCtx, &types.MsgCreateWhois{Creator: owner, Name: tc.name, Address: owner, Price: "5trycoin"})
+		require.NoError(t, err, tc.name)
+		require.Equal(t, ownerBalance-tc.cost, balance(k, ctx, owner), tc.name)
+	}
+
+	// Registration fails without funds for the premium
+	poor := sdk.AccAddress("poor________________").String()
+	keepertest.FundAccount(t, k, ctx, mustAddress(poor), sdk.NewCoins(sdk.NewInt64Coin("trycoin", 109)))
+	_, err := srv.CreateWhois(goCtx, &types.MsgCreateWhois{Creator: poor, Name: "xyz.wallet", Address: poor, Price: "5trycoin"})
+	require.ErrorIs(t, err, types.ErrInsufficientFee)
+	require.False(t, k.IsNamePresent(ctx, "xyz.wallet"))
+}
+
+func TestMsgServerRenameChargesRegistrationCost(t *testing.T) {
+	srv, k, ctx, goCtx := setupMsgServer(t)
+	_, err := srv.CreateWhois(goCtx, &types.MsgCreateWhois{Creator: owner, Name: "abcdef.wallet", Address: owner, Price: "5trycoin"})
+	require.NoError(t, err)
+
+	// Updates that keep the name pay UpdateWhoisPrice
+	ownerBalance := balance(k, ctx, owner)
+	_, err = srv.UpdateWhois(goCtx, &types.MsgUpdateWhois{Creator: owner, CurrentName: "abcdef.wallet", Name: "abcdef.wallet", Address: owner, Price: "7trycoin"})
+	require.NoError(t, err)
+	require.Equal(t, ownerBalance-5, balance(k, ctx, owner))
+
+	// Renaming to a short name also pays its registration cost
+	ownerBalance = balance(k, ctx, owner)
+	_, err = srv.UpdateWhois(goCtx, &types.MsgUpdateWhois{Creator: owner, CurrentName: "abcdef.wallet", Name: "abc.wallet", UpdateMask: []string{types.UpdateMaskName}})
+	require.NoError(t, err)
+	require.Equal(t, ownerBalance-5-110, balance(k, ctx, owner))
+	require.True(t, k.IsNamePresent(ctx, "abc.wallet"))
+}
+
+func TestMsgServerFeeDestinations(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("trycoin", 10))
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	poolAddr := authtypes.NewModuleAddress(distrtypes.ModuleName)
+
+	for _, tc := range []struct {
+		destination string
+		totals      types.FeeTotals
+		module      sdk.Coins
+		pool        sdk.Coins
+		supply      sdk.Coins
+	}{
+		{
+			destination: types.FeeDestinationBurn,
+			totals:      types.FeeTotals{Collected: fee, Burned: fee},
+			supply:      sdk.NewCoins(sdk.NewInt64Coin("trycoin", 1990)),
+		},
+		{
+			destination: types.FeeDestinationCommunityPool,
+			totals:      types.FeeTotals{Collected: fee, CommunityPool: fee},
+			pool:        fee,
+			supply:      sdk.NewCoins(sdk.NewInt64Coin("trycoin", 2000)),
+		},
+		{
+			destination: types.FeeDestinationTreasury,
+			totals:      types.FeeTotals{Collected: fee, Treasury: fee},
+			module:      fee,
+			supply:      sdk.NewCoins(sdk.NewInt64Coin("trycoin", 2000)),
+		},
+	} {
+		tc := tc
+		t.Run(tc.destination, func(t *testing.T) {
+			srv, k, ctx, goCtx := setupMsgServer(t)
+			params := types.DefaultParams()
+			params.FeeDestination = tc.destination
+			k.SetParams(ctx, params)
+
+			_, err := srv.CreateWhois(goCtx, &types.MsgCreateWhois{Creator: owner, Name: "abcdef.wallet", Address: owner, Price: "5trycoin"})
+			require.NoError(t, err)
+
+			require.EqualValues(t, 990, balance(k, ctx, owner))
+			totals := k.GetFeeTotals(ctx)
+			require.Equal(t, tc.totals.String(), totals.String())
+			require.Equal(t, tc.module.String(), k.CoinKeeper.GetAllBalances(ctx, moduleAddr).String())
+			require.Equal(t, tc.pool.String(), k.CoinKeeper.GetAllBalances(ctx, poolAddr).String())
+			require.Equal(t, tc.supply.String(), k.CoinKeeper.GetSupply(ctx).GetTotal().String())
+
+			// Totals add up across fees
+			_, err = srv.DeleteWhois(goCtx, &types.MsgDeleteWhois{Creator: owner, Name: "abcdef.wallet"})
+			require.NoError(t, err)
+			require.Equal(t, "11trycoin", k.GetFeeTotals(ctx).Collected.String())
+
+			msg, broken := keeper.AllInvariants(*k)(ctx)
+			require.False(t, broken, msg)
+		})
+	}
+}
+
+func TestMsgServerUpdateWhoisMask(t *testing.T) {
+	srv, k, ctx, goCtx := setupMsgServer(t)
+	_, err := srv.CreateWhois(goCtx, &types.MsgCreateWhois{Creator: owner, Name: "alpha.wallet", Address: owner, Price: "5trycoin"})
+	require.NoError(t, err)
+
+	// Fields outside the mask are left alone
+	res, err := srv.UpdateWhois(goCtx, &types.MsgUpdateWhois{
+		Creator: owner, CurrentName: "alpha.wallet", Address: buyer, Price: "9trycoin", UpdateMask: []string{types.UpdateMaskPrice},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, res.Revision)
+	whois, _ := k.GetWhoisByName(ctx, "alpha.wallet")
+	require.Equal(t, owner, whois.Address)
+	require.Equal(t, "9trycoin", whois.Price)
+
+	res, err = srv.UpdateWhois(goCtx, &types.MsgUpdateWhois{
+		Creator: owner, CurrentName: "alpha.wallet", Address: buyer, UpdateMask: []string{types.UpdateMaskAddress},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, res.Revision)
+	whois, _ = k.GetWhoisByName(ctx, "alpha.wallet")
+	require.Equal(t, buyer, whois.Address)
+	require.Equal(t, "9trycoin", whois.Price)
+
+	// An empty mask updates every field
+	_, err = srv.UpdateWhois(goCtx, &types.MsgUpdateWhois{
+		Creator: owner, CurrentName: "alpha.wallet", Name: "beta.wallet", Address: owner, Price: "3trycoin",
+	})
+	require.NoError(t, err)
+	require.False(t, k.IsNamePresent(ctx, "alpha.wallet"))
+	whois, _ = k.GetWhoisByName(ctx, "beta.wallet")
+	require.Equal(t, owner, whois.Address)
+	require.Equal(t, "3trycoin", whois.Price)
+	require.EqualValues(t, 4, whois.Revision)
+}
+
+func TestMsgServerUpdateWhoisRevision(t *testing.T) {
+	srv, k, ctx, goCtx := setupMsgServer(t)
+	_, err := srv.CreateWhois(goCtx, &types.MsgCreateWhois{Creator: owner, Name: "alpha.wallet", Address: owner, Price: "5trycoin"})
+	require.NoError(t, err)
+
+	update := types.MsgUpdateWhois{
+		Creator: owner, CurrentName: "alpha.wallet", Price: "7trycoin", UpdateMask: []string{types.UpdateMaskPrice}, ExpectedRevision: 1,
+	}
+	res, err := srv.UpdateWhois(goCtx, &update)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, res.Revision)
+
+	// A client that read the whois before the first update loses, and isn't charged
+	ownerBalance := balance(k, ctx, owner)
+	update.Price = "8trycoin"
+	_, err = srv.UpdateWhois(goCtx, &update)
+	require.ErrorIs(t, err, types.ErrRevisionMismatch)
+	require.Equal(t, ownerBalance, balance(k, ctx, owner))
+	whois, _ := k.GetWhoisByName(ctx, "alpha.wallet")
+	require.Equal(t, "7trycoin", whois.Price)
+
+	// Other changes of the record bump the revision too
+	_, err = srv.SetWhoisForSale(goCtx, &types.MsgSetWhoisForSale{Creator: owner, Name: "alpha.wallet", ForSale: true})
+	require.NoError(t, err)
+	update.ExpectedRevision = 2
+	_, err = srv.UpdateWhois(goCtx, &update)
+	require.ErrorIs(t, err, types.ErrRevisionMismatch)
+
+	// 0 skips the check
+	update.ExpectedRevision = 0
+	res, err = srv.UpdateWhois(goCtx, &update)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, res.Revision)
+}
@@ -7,29 +7,78 @@ import (
 )
 
 // MinimumCreateWhoisPrice
-func (k Keeper) CreateWhoisPrice(ctx sdk.Context) (res string) {
+func (k Keeper) CreateWhoisPrice(ctx sdk.Context) (res sdk.Coins) {
 	k.paramSpace.Get(ctx, types.KeyCreateWhoisPrice, &res)
 	return
 }
 
 // UpdateWhoisPrice
-func (k Keeper) UpdateWhoisPrice(ctx sdk.Context) (res string) {
+func (k Keeper) UpdateWhoisPrice(ctx sdk.Context) (res sdk.Coins) {
 	k.paramSpace.Get(ctx, types.KeyUpdateWhoisPrice, &res)
 	return
 }
 
 // DeleteWhoisPrice
-func (k Keeper) DeleteWhoisPrice(ctx sdk.Context) (res string) {
+func (k Keeper) DeleteWhoisPrice(ctx sdk.Context) (res sdk.Coins) {
 	k.paramSpace.Get(ctx, types.KeyDeleteWhoisPrice, &res)
 	return
 }
 
+// RenewWhoisPrice
+func (k Keeper) RenewWhoisPrice(ctx sdk.Context) (res sdk.Coins) {
+	k.paramSpace.Get(ctx, types.KeyRenewWhoisPrice, &res)
+	return
+}
+
+// RegistrationPeriod
+func (k Keeper) RegistrationPeriod(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.KeyRegistrationPeriod, &res)
+	return
+}
+
+// ExpiryGracePeriod
+func (k Keeper) ExpiryGracePeriod(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.KeyExpiryGracePeriod, &res)
+	return
+}
+
+// MaxExpirationsPerBlock
+func (k Keeper) MaxExpirationsPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxExpirationsPerBlock, &res)
+	return
+}
+
+// TldPrices
+func (k Keeper) TldPrices(ctx sdk.Context) (res []types.TldPrice) {
+	k.paramSpace.Get(ctx, types.KeyTldPrices, &res)
+	return
+}
+
+// LengthPrices
+func (k Keeper) LengthPrices(ctx sdk.Context) (res []types.LengthPrice) {
+	k.paramSpace.Get(ctx, types.KeyLengthPrices, &res)
+	return
+}
+
+// FeeDestination
+func (k Keeper) FeeDestination(ctx sdk.Context) (res string) {
+	k.paramSpace.Get(ctx, types.KeyFeeDestination, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.CreateWhoisPrice(ctx),
 		k.UpdateWhoisPrice(ctx),
 		k.DeleteWhoisPrice(ctx),
+		k.RenewWhoisPrice(ctx),
+		k.RegistrationPeriod(ctx),
+		k.ExpiryGracePeriod(ctx),
+		k.MaxExpirationsPerBlock(ctx),
+		k.TldPrices(ctx),
+		k.LengthPrices(ctx),
+		k.FeeDestination(ctx),
 	)
 }
 
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+// GetRegistrationCost returns the price of registering name
+//
+// The base price is the price of the name's TLD, falling back to CreateWhoisPrice,
+// and the premium of the tightest length tier matching the label is added to it.
+func (k Keeper) GetRegistrationCost(ctx sdk.Context, name string) sdk.Coins {
+	label, tld := name, ""
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		label, tld = name[:i], name[i+1:]
+	}
+
+	cost := k.CreateWhoisPrice(ctx)
+	for _, tp := range k.TldPrices(ctx) {
+		if tp.Tld == tld {
+			cost = tp.Price
+			break
+		}
+	}
+
+	length := uint64(utf8.RuneCountInString(label))
+	var tier *types.LengthPrice
+	for _, lp := range k.LengthPrices(ctx) {
+		lp := lp
+		if length <= lp.MaxLength && (tier == nil || lp.MaxLength < tier.MaxLength) {
+			tier = &lp
+		}
+	}
+
+	if tier != nil {
+		cost = cost.Add(tier.Price...)
+	}
+
+	return cost
+}
@@ -21,11 +21,29 @@ func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 		switch path[0] {
 		// this line is used by starport scaffolding # 2
 		case types.QueryGetWhois:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing whois name or id")
+			}
 			return getWhois(ctx, path[1], k, legacyQuerierCdc)
 
 		case types.QueryListWhois:
 			return listWhois(ctx, k, legacyQuerierCdc)
 
+		case types.QueryResolveName:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing name to resolve")
+			}
+			return resolveName(ctx, path[1], k, legacyQuerierCdc)
+
+		case types.QueryNamesByAddress:
+			return namesByAddress(ctx, req.Data, k, legacyQuerierCdc)
+
+		case types.QueryWhoisByOwner:
+			return whoisByOwner(ctx, req.Data, k, legacyQuerierCdc)
+
+		case types.QueryParams:
+			return queryParams(ctx, k, legacyQuerierCdc)
+
 		default:
 			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
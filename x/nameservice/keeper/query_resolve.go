@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+func resolveName(ctx sdk.Context, name string, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	name = types.CanonicalName(name)
+	whois, found := keeper.GetWhoisByName(ctx, name)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNameNotFound, "name %s is not registered", name)
+	}
+
+	res := types.QueryResolveResponse{Address: whois.Address, Whois: &whois}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
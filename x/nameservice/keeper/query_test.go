@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	keepertest "github.com/enqack/nameservice/testutil/keeper"
+	"github.com/enqack/nameservice/x/nameservice/keeper"
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+func TestQuerierMissingArgument(t *testing.T) {
+	k, ctx, _ := keepertest.NameserviceKeeper(t)
+	querier := keeper.NewQuerier(*k, codec.NewLegacyAmino())
+
+	for _, path := range [][]string{{types.QueryResolveName}, {types.QueryGetWhois}} {
+		_, err := querier(ctx, path, abci.RequestQuery{})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest, "path %v", path)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
 )
 
 func listWhois(ctx sdk.Context, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
@@ -17,8 +19,19 @@ func listWhois(ctx sdk.Context, keeper Keeper, legacyQuerierCdc *codec.LegacyAmi
 	return bz, nil
 }
 
-func getWhois(ctx sdk.Context, id string, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
-	msg := keeper.GetWhois(ctx, id)
+// getWhois returns the whois registered under the name key, or with the id key
+func getWhois(ctx sdk.Context, key string, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var name, id string
+	if types.IsWhoisId(key) {
+		id = key
+	} else {
+		name = types.CanonicalName(key)
+	}
+
+	msg, err := keeper.getWhoisByNameOrId(ctx, name, id)
+	if err != nil {
+		return nil, err
+	}
 
 	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, msg)
 	if err != nil {
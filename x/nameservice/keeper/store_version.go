@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+// GetStoreVersion returns the version of the store layout, stores written before the
+// version was recorded are at version 1
+func (k Keeper) GetStoreVersion(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.StoreVersionKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetStoreVersion records the version of the store layout
+func (k Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	ctx.KVStore(k.storeKey).Set(types.StoreVersionKey, sdk.Uint64ToBigEndian(version))
+}
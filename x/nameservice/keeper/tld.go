@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+// SetTld set a top level domain in the store
+func (k Keeper) SetTld(ctx sdk.Context, tld types.Tld) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TldKeyPrefix)
+	b := k.cdc.MustMarshalBinaryBare(&tld)
+	store.Set([]byte(tld.Name), b)
+}
+
+// GetTld returns a top level domain from its name
+func (k Keeper) GetTld(ctx sdk.Context, name string) (types.Tld, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TldKeyPrefix)
+	bz := store.Get([]byte(name))
+	if bz == nil {
+		return types.Tld{}, false
+	}
+
+	var tld types.Tld
+	k.cdc.MustUnmarshalBinaryBare(bz, &tld)
+	return tld, true
+}
+
+// IsTldEnabled - check if new names can be registered under a top level domain
+func (k Keeper) IsTldEnabled(ctx sdk.Context, name string) bool {
+	tld, found := k.GetTld(ctx, name)
+	return found && tld.Enabled
+}
+
+// GetAllTld returns all top level domains
+func (k Keeper) GetAllTld(ctx sdk.Context) (tlds []types.Tld) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TldKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tld types.Tld
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &tld)
+		tlds = append(tlds, tld)
+	}
+
+	return
+}
@@ -2,51 +2,36 @@ package keeper
 
 import (
 	"strconv"
-	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	validator "github.com/asaskevich/govalidator"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/enqack/nameservice/x/nameservice/types"
 )
 
 
-var (
-	whoisTDL = [...]string{"wallet", "contract", "validator"}
-)
-
 // GetWhoisCount get the total number of whois
 func (k Keeper) GetWhoisCount(ctx sdk.Context) int64 {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhoisCountKey))
-	byteKey := types.KeyPrefix(types.WhoisCountKey)
-	bz := store.Get(byteKey)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.WhoisCountKey)
 
 	// Count doesn't exist: no element
 	if bz == nil {
 		return 0
 	}
 
-	// Parse bytes
-	count, err := strconv.ParseInt(string(bz), 10, 64)
-	if err != nil {
-		// Panic because the count should be always formattable to int64
-		panic("cannot decode count")
-	}
-
-	return count
+	return int64(sdk.BigEndianToUint64(bz))
 }
 
 // SetWhoisCount set the total number of whois
 func (k Keeper) SetWhoisCount(ctx sdk.Context, count int64) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhoisCountKey))
-	byteKey := types.KeyPrefix(types.WhoisCountKey)
-	bz := []byte(strconv.FormatInt(count, 10))
-	store.Set(byteKey, bz)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.WhoisCountKey, sdk.Uint64ToBigEndian(uint64(count)))
 }
 
-// CreateWhois creates a whois with a new id and update the count
-func (k Keeper) CreateWhois(ctx sdk.Context, msg types.MsgCreateWhois) {
+// CreateWhois creates a whois with a new id, update the count and returns the new whois
+func (k Keeper) CreateWhois(ctx sdk.Context, msg types.MsgCreateWhois) types.Whois {
 	// Create the whois
 	count := k.GetWhoisCount(ctx)
 	var whois = types.Whois{
@@ -55,53 +40,108 @@ func (k Keeper) CreateWhois(ctx sdk.Context, msg types.MsgCreateWhois) {
 		Name:    msg.Name,
 		Address: msg.Address,
 		Price:   msg.Price,
+		// New registrations are only for sale once the owner lists them
+		ForSale:  false,
+		Revision: 1,
 	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhoisKey))
-	key := types.KeyPrefix(types.WhoisKey + whois.Id)
-	value := k.cdc.MustMarshalBinaryBare(&whois)
-	store.Set(key, value)
+	// Registrations lapse after a registration period unless renewed
+	if period := k.RegistrationPeriod(ctx); period > 0 {
+		whois.Expires = ctx.BlockHeight() + period
+	}
+
+	whois = k.SetWhois(ctx, whois)
 
 	// Update whois count
 	k.SetWhoisCount(ctx, count+1)
+
+	return whois
 }
 
-// SetWhois set a specific whois in the store
-func (k Keeper) SetWhois(ctx sdk.Context, whois types.Whois) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhoisKey))
+// SetWhois set a specific whois in the store and keeps the secondary indexes in sync.
+// Replacing a whois bumps its revision, the stored whois is returned.
+func (k Keeper) SetWhois(ctx sdk.Context, whois types.Whois) types.Whois {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisKeyPrefix)
+	key := types.WhoisIdKey(whois.Id)
+
+	// Drop the index entries of the record being replaced
+	if bz := store.Get(key); bz != nil {
+		var old types.Whois
+		k.cdc.MustUnmarshalBinaryBare(bz, &old)
+		k.removeWhoisIndexes(ctx, old)
+
+		// A primary name designation doesn't survive a change of name, target or owner
+		if old.Name != whois.Name || old.Address != whois.Address || old.Creator != whois.Creator {
+			k.removePrimaryName(ctx, old)
+		}
+
+		// A pending transfer is void once the name or the owner changed
+		if old.Name != whois.Name || old.Creator != whois.Creator {
+			k.DeleteWhoisTransfer(ctx, old.Id)
+		}
+
+		whois.Revision = old.Revision + 1
+	}
+
 	b := k.cdc.MustMarshalBinaryBare(&whois)
-	store.Set(types.KeyPrefix(types.WhoisKey+whois.Id), b)
+	store.Set(key, b)
+	k.setWhoisIndexes(ctx, whois)
+
+	return whois
 }
 
 // GetWhois returns a whois from its id
-func (k Keeper) GetWhois(ctx sdk.Context, key string) types.Whois {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhoisKey))
+func (k Keeper) GetWhois(ctx sdk.Context, key string) (types.Whois, bool) {
+	if !types.IsWhoisId(key) {
+		return types.Whois{}, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisKeyPrefix)
+	bz := store.Get(types.WhoisIdKey(key))
+	if bz == nil {
+		return types.Whois{}, false
+	}
+
 	var whois types.Whois
-	k.cdc.MustUnmarshalBinaryBare(store.Get(types.KeyPrefix(types.WhoisKey+key)), &whois)
-	return whois
+	k.cdc.MustUnmarshalBinaryBare(bz, &whois)
+	return whois, true
 }
 
 // HasWhois checks if the whois exists
 func (k Keeper) HasWhois(ctx sdk.Context, id string) bool {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhoisKey))
-	return store.Has(types.KeyPrefix(types.WhoisKey + id))
+	if !types.IsWhoisId(id) {
+		return false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisKeyPrefix)
+	return store.Has(types.WhoisIdKey(id))
 }
 
-// GetWhoisOwner returns the creator of the whois
-func (k Keeper) GetWhoisOwner(ctx sdk.Context, key string) string {
-	return k.GetWhois(ctx, key).Creator
+// GetWhoisOwner returns the owner of the name, empty if the name isn't registered
+func (k Keeper) GetWhoisOwner(ctx sdk.Context, name string) string {
+	whois, _ := k.GetWhoisByName(ctx, name)
+	return whois.Creator
 }
 
-// DeleteWhois deletes a whois
+// DeleteWhois deletes a whois and its secondary index entries
 func (k Keeper) DeleteWhois(ctx sdk.Context, key string) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhoisKey))
-	store.Delete(types.KeyPrefix(types.WhoisKey + key))
+	whois, found := k.GetWhois(ctx, key)
+	if !found {
+		return
+	}
+
+	k.removeWhoisIndexes(ctx, whois)
+	k.removePrimaryName(ctx, whois)
+	k.DeleteWhoisTransfer(ctx, whois.Id)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisKeyPrefix)
+	store.Delete(types.WhoisIdKey(key))
 }
 
-// GetAllWhois returns all whois
+// GetAllWhois returns all whois in the order they were registered
 func (k Keeper) GetAllWhois(ctx sdk.Context) (msgs []types.Whois) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhoisKey))
-	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefix(types.WhoisKey))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisKeyPrefix)
+	iterator := store.Iterator(nil, nil)
 
 	defer iterator.Close()
 
@@ -121,117 +161,108 @@ func (k Keeper) GetAllWhois(ctx sdk.Context) (msgs []types.Whois) {
 
 // IsNamePresent - check if name is present in the store or not
 func (k Keeper) IsNamePresent(ctx sdk.Context, name string) bool {
-	for _, whois := range k.GetAllWhois(ctx) {
-		if whois.Name == name {
-			return true
-		}
-	}
-	return false
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisNameKeyPrefix)
+	return store.Has([]byte(name))
 }
 
-// VerifyNameFormat - check if name is a valid format
-func (k Keeper) VerifyNameFormat(ctx sdk.Context, name string) bool {
-	// name is invalid if it does not conform to a DNS name
-	if !validator.IsDNSName(name) {
-		return false
-	}
-	// check if part after last period is a top level domain
-	score := 0 // 0 equals no TLD found
-	nameParts := strings.Split(name, ".")
-	for _, tld := range whoisTDL {
-		if nameParts[len(nameParts)-1] == tld {
-			score++
-		}
+// ValidateNameFormat - check if a new name can be registered under its top level domain
+func (k Keeper) ValidateNameFormat(ctx sdk.Context, name string) error {
+	tld, err := types.ValidateName(name)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidName, err.Error())
 	}
-	if score == 0 {
-		return false
+	// check if part after last period is an enabled top level domain
+	if !k.IsTldEnabled(ctx, tld) {
+		return sdkerrors.Wrapf(types.ErrUnknownTLD, "tld %s", tld)
 	}
-	return true
+	return nil
 }
 
 // IsAddress - check if address is a valid format
 func (k Keeper) IsValidAddress(ctx sdk.Context, address string) bool {
-	addr, err := sdk.AccAddressFromBech32(address)
-	if err != nil {
-		return false
-	}
-	if err := sdk.VerifyAddressFormat(addr); err != nil {
-		return false
-	}
-	return true
+	return types.ValidateAddress(address) == nil
 }
 
 //
 // Functions used by querier
 //
 
-// Get creator of the item
-func (k Keeper) GetCreator(ctx sdk.Context, key string) string {
-	whois := k.GetWhois(ctx, key)
+// Get creator of the name
+func (k Keeper) GetCreator(ctx sdk.Context, name string) string {
+	whois, _ := k.GetWhoisByName(ctx, name)
 	return whois.Creator
 }
 
-// Check if the key exists in the store
-func (k Keeper) Exists(ctx sdk.Context, key string) bool {
-	store := ctx.KVStore(k.storeKey)
-	return store.Has([]byte(types.WhoisKey + key))
+// Check if the name exists in the store
+func (k Keeper) Exists(ctx sdk.Context, name string) bool {
+	return k.IsNamePresent(ctx, name)
 }
 
 // ResolveName - returns the string that the name resolves to
 func (k Keeper) ResolveName(ctx sdk.Context, name string) string {
-	whois := k.GetWhois(ctx, name)
+	whois, _ := k.GetWhoisByName(ctx, name)
 	return whois.Address
 }
 
-// SetName - sets the value string that a name resolves to
-func (k Keeper) SetName(ctx sdk.Context, name string, address string) {
-	whois := k.GetWhois(ctx, name)
-	whois.Name = name
+// SetName - renames the whois registered under name
+func (k Keeper) SetName(ctx sdk.Context, name string, newName string) {
+	whois, found := k.GetWhoisByName(ctx, name)
+	if !found {
+		return
+	}
+	whois.Name = newName
 	k.SetWhois(ctx, whois)
 }
 
 // SetAddress - sets the address string that a name resolves to
 func (k Keeper) SetAddress(ctx sdk.Context, name string, address string) {
-	whois := k.GetWhois(ctx, name)
-	whois.Address =  address
+	whois, found := k.GetWhoisByName(ctx, name)
+	if !found {
+		return
+	}
+	whois.Address = address
 	k.SetWhois(ctx, whois)
 }
 
 // HasOwner - returns whether or not the name already has an owner
 func (k Keeper) HasCreator(ctx sdk.Context, name string) bool {
-	whois := k.GetWhois(ctx, name)
+	whois, _ := k.GetWhoisByName(ctx, name)
 	return len(whois.Creator) != 0
 }
 
 // SetOwner - sets the current owner of a name
 func (k Keeper) SetCreator(ctx sdk.Context, name string, creator string) {
-	whois := k.GetWhois(ctx, name)
+	whois, found := k.GetWhoisByName(ctx, name)
+	if !found {
+		return
+	}
 	whois.Creator = creator
 	k.SetWhois(ctx, whois)
 }
 
 // GetPrice - gets the current price of a name
 func (k Keeper) GetPrice(ctx sdk.Context, name string) string {
-	whois := k.GetWhois(ctx, name)
+	whois, _ := k.GetWhoisByName(ctx, name)
 	return whois.Price
 }
 
 // SetPrice - sets the current price of a name
 func (k Keeper) SetPrice(ctx sdk.Context, name string, price string) {
-	whois := k.GetWhois(ctx, name)
+	whois, found := k.GetWhoisByName(ctx, name)
+	if !found {
+		return
+	}
 	whois.Price = price
 	k.SetWhois(ctx, whois)
 }
 
-// Get an iterator over all names in which the keys are the names and the values are the whois
+// Get an iterator over all names in which the keys are the names and the values are the big-endian ids of their whois
 func (k Keeper) GetNamesIterator(ctx sdk.Context) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
-	return sdk.KVStorePrefixIterator(store, []byte(types.WhoisKey))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisNameKeyPrefix)
+	return store.Iterator(nil, nil)
 }
 
-// Check if the key exists in the store
-func (k Keeper) WhoisExists(ctx sdk.Context, key string) bool {
-	store := ctx.KVStore(k.storeKey)
-	return store.Has([]byte(types.WhoisKey + key))
+// Check if the name exists in the store
+func (k Keeper) WhoisExists(ctx sdk.Context, name string) bool {
+	return k.IsNamePresent(ctx, name)
 }
-
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+// GetWhoisIdByName returns the id of the whois registered under name
+func (k Keeper) GetWhoisIdByName(ctx sdk.Context, name string) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisNameKeyPrefix)
+	bz := store.Get([]byte(name))
+	if bz == nil {
+		return "", false
+	}
+	return types.WhoisIdFromKey(bz), true
+}
+
+// GetWhoisByName returns a whois from its name
+func (k Keeper) GetWhoisByName(ctx sdk.Context, name string) (types.Whois, bool) {
+	id, found := k.GetWhoisIdByName(ctx, name)
+	if !found {
+		return types.Whois{}, false
+	}
+	return k.GetWhois(ctx, id)
+}
+
+// getWhoisByNameOrId returns the whois registered under name, or with id when name is empty
+func (k Keeper) getWhoisByNameOrId(ctx sdk.Context, name string, id string) (types.Whois, error) {
+	if name == "" {
+		whois, found := k.GetWhois(ctx, id)
+		if !found {
+			return types.Whois{}, sdkerrors.Wrapf(types.ErrWhoisNotFound, "key %s doesn't exist", id)
+		}
+		return whois, nil
+	}
+
+	whois, found := k.GetWhoisByName(ctx, name)
+	if !found {
+		return types.Whois{}, sdkerrors.Wrapf(types.ErrNameNotFound, "name %s doesn't exist", name)
+	}
+	return whois, nil
+}
+
+// GetPrimaryName returns the primary name designated for address
+func (k Keeper) GetPrimaryName(ctx sdk.Context, address string) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisPrimaryKeyPrefix)
+	bz := store.Get([]byte(address))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetPrimaryName designates name as the primary name of address
+func (k Keeper) SetPrimaryName(ctx sdk.Context, address string, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisPrimaryKeyPrefix)
+	store.Set([]byte(address), []byte(name))
+}
+
+// GetAllPrimaryName returns the primary names of all addresses
+func (k Keeper) GetAllPrimaryName(ctx sdk.Context) (primaryNames []types.PrimaryName) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisPrimaryKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		primaryNames = append(primaryNames, types.PrimaryName{
+			Address: string(iterator.Key()),
+			Name:    string(iterator.Value()),
+		})
+	}
+
+	return
+}
+
+// removePrimaryName clears the primary name of the whois address if it is the whois name
+func (k Keeper) removePrimaryName(ctx sdk.Context, whois types.Whois) {
+	if primary, found := k.GetPrimaryName(ctx, whois.Address); found && primary == whois.Name {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisPrimaryKeyPrefix)
+		store.Delete([]byte(whois.Address))
+	}
+}
+
+// setWhoisIndexes writes the secondary index entries of a whois
+func (k Keeper) setWhoisIndexes(ctx sdk.Context, whois types.Whois) {
+	id := types.WhoisIdKey(whois.Id)
+
+	nameStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisNameKeyPrefix)
+	nameStore.Set([]byte(whois.Name), id)
+
+	addressStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisAddressPrefix(whois.Address))
+	addressStore.Set([]byte(whois.Name), id)
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisOwnerPrefix(whois.Creator))
+	ownerStore.Set([]byte(whois.Name), id)
+
+	if whois.Expires > 0 {
+		expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisExpiryKeyPrefix)
+		expiryStore.Set(types.WhoisExpiryQueueKey(whois.Expires, whois.Id), id)
+	}
+}
+
+// removeWhoisIndexes deletes the secondary index entries of a whois
+func (k Keeper) removeWhoisIndexes(ctx sdk.Context, whois types.Whois) {
+	id := types.WhoisIdKey(whois.Id)
+
+	nameStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisNameKeyPrefix)
+	// Only remove the entry if it still belongs to this record
+	if bytes.Equal(nameStore.Get([]byte(whois.Name)), id) {
+		nameStore.Delete([]byte(whois.Name))
+	}
+
+	addressStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisAddressPrefix(whois.Address))
+	if bytes.Equal(addressStore.Get([]byte(whois.Name)), id) {
+		addressStore.Delete([]byte(whois.Name))
+	}
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisOwnerPrefix(whois.Creator))
+	if bytes.Equal(ownerStore.Get([]byte(whois.Name)), id) {
+		ownerStore.Delete([]byte(whois.Name))
+	}
+
+	if whois.Expires > 0 {
+		expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisExpiryKeyPrefix)
+		expiryStore.Delete(types.WhoisExpiryQueueKey(whois.Expires, whois.Id))
+	}
+}
@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/enqack/nameservice/testutil/keeper"
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+var owner = sdk.AccAddress("owner_______________").String()
+
+func TestGetWhois(t *testing.T) {
+	k, ctx, _ := keepertest.NameserviceKeeper(t)
+	created := k.CreateWhois(ctx, types.MsgCreateWhois{Creator: owner, Name: "alpha.wallet", Address: owner, Price: "5trycoin"})
+
+	whois, found := k.GetWhois(ctx, created.Id)
+	require.True(t, found)
+	require.Equal(t, created, whois)
+
+	for _, id := range []string{"1", "", "alpha.wallet", "-1"} {
+		whois, found := k.GetWhois(ctx, id)
+		require.False(t, found, "id %q", id)
+		require.Equal(t, types.Whois{}, whois)
+	}
+
+	k.DeleteWhois(ctx, created.Id)
+	_, found = k.GetWhois(ctx, created.Id)
+	require.False(t, found)
+	_, found = k.GetWhoisByName(ctx, created.Name)
+	require.False(t, found)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+// SetWhoisTransfer set a pending transfer in the store, replacing any earlier offer
+func (k Keeper) SetWhoisTransfer(ctx sdk.Context, transfer types.WhoisTransfer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisTransferKeyPrefix)
+	b := k.cdc.MustMarshalBinaryBare(&transfer)
+	store.Set(types.WhoisIdKey(transfer.Id), b)
+}
+
+// GetWhoisTransfer returns the pending transfer of a whois from its id
+func (k Keeper) GetWhoisTransfer(ctx sdk.Context, id string) (types.WhoisTransfer, bool) {
+	if !types.IsWhoisId(id) {
+		return types.WhoisTransfer{}, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisTransferKeyPrefix)
+	bz := store.Get(types.WhoisIdKey(id))
+	if bz == nil {
+		return types.WhoisTransfer{}, false
+	}
+
+	var transfer types.WhoisTransfer
+	k.cdc.MustUnmarshalBinaryBare(bz, &transfer)
+	return transfer, true
+}
+
+// DeleteWhoisTransfer deletes the pending transfer of a whois
+func (k Keeper) DeleteWhoisTransfer(ctx sdk.Context, id string) {
+	if !types.IsWhoisId(id) {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisTransferKeyPrefix)
+	store.Delete(types.WhoisIdKey(id))
+}
+
+// GetAllWhoisTransfer returns all pending transfers
+func (k Keeper) GetAllWhoisTransfer(ctx sdk.Context) (transfers []types.WhoisTransfer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisTransferKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.WhoisTransfer
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+
+	return
+}
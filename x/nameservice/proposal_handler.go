@@ -0,0 +1,43 @@
+package nameservice
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/enqack/nameservice/x/nameservice/keeper"
+	"github.com/enqack/nameservice/x/nameservice/types"
+)
+
+// NewTldProposalHandler creates a governance handler to manage top level domains
+func NewTldProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetTldProposal:
+			return handleSetTldProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleSetTldProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetTldProposal) error {
+	if err := p.Tld.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetTld(ctx, p.Tld)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventTldSet{
+		Name:    p.Tld.Name,
+		Enabled: p.Tld.Enabled,
+	})
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("set tld by governance", "tld", p.Tld.Name, "enabled", p.Tld.Enabled)
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -11,6 +13,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateWhois{}, "nameservice/CreateWhois", nil)
 	cdc.RegisterConcrete(&MsgUpdateWhois{}, "nameservice/UpdateWhois", nil)
 	cdc.RegisterConcrete(&MsgDeleteWhois{}, "nameservice/DeleteWhois", nil)
+	cdc.RegisterConcrete(&MsgSetPrimaryName{}, "nameservice/SetPrimaryName", nil)
+	cdc.RegisterConcrete(&MsgRenewWhois{}, "nameservice/RenewWhois", nil)
+	cdc.RegisterConcrete(&MsgBuyWhois{}, "nameservice/BuyWhois", nil)
+	cdc.RegisterConcrete(&MsgSetWhoisForSale{}, "nameservice/SetWhoisForSale", nil)
+	cdc.RegisterConcrete(&MsgTransferWhois{}, "nameservice/TransferWhois", nil)
+	cdc.RegisterConcrete(&MsgAcceptWhoisTransfer{}, "nameservice/AcceptWhoisTransfer", nil)
+	cdc.RegisterConcrete(&MsgCancelWhoisTransfer{}, "nameservice/CancelWhoisTransfer", nil)
+	cdc.RegisterConcrete(&SetTldProposal{}, "nameservice/SetTldProposal", nil)
 
 }
 
@@ -20,7 +30,20 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateWhois{},
 		&MsgUpdateWhois{},
 		&MsgDeleteWhois{},
+		&MsgSetPrimaryName{},
+		&MsgRenewWhois{},
+		&MsgBuyWhois{},
+		&MsgSetWhoisForSale{},
+		&MsgTransferWhois{},
+		&MsgAcceptWhoisTransfer{},
+		&MsgCancelWhoisTransfer{},
 	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&SetTldProposal{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 var (
@@ -3,10 +3,77 @@ package types
 // DONTCOVER
 
 import (
+	"errors"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // x/nameservice module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrNameTaken        = sdkerrors.Register(ModuleName, 2, "name is already registered")
+	ErrInvalidName      = sdkerrors.Register(ModuleName, 3, "invalid name")
+	ErrUnknownTLD       = sdkerrors.Register(ModuleName, 4, "unknown or disabled top level domain")
+	ErrNotOwner         = sdkerrors.Register(ModuleName, 5, "sender is not the owner of the name")
+	ErrNameNotFound     = sdkerrors.Register(ModuleName, 6, "name not found")
+	ErrInsufficientFee  = sdkerrors.Register(ModuleName, 7, "insufficient funds to pay the fee")
+	ErrNameExpired      = sdkerrors.Register(ModuleName, 8, "name has expired")
+	ErrInvalidPrice     = sdkerrors.Register(ModuleName, 9, "invalid price")
+	ErrNotForSale       = sdkerrors.Register(ModuleName, 10, "name is not for sale")
+	ErrPriceExceeded    = sdkerrors.Register(ModuleName, 11, "price exceeds the max price")
+	ErrWhoisNotFound    = sdkerrors.Register(ModuleName, 12, "whois not found")
+	ErrNoExpiry         = sdkerrors.Register(ModuleName, 13, "name does not expire")
+	ErrTransferNotFound = sdkerrors.Register(ModuleName, 14, "name has no pending transfer")
+	ErrNotRecipient     = sdkerrors.Register(ModuleName, 15, "sender is not the recipient of the transfer")
+	ErrAlreadyOwner     = sdkerrors.Register(ModuleName, 16, "account already owns the name")
+	ErrInvalidId        = sdkerrors.Register(ModuleName, 17, "invalid whois id")
+	ErrRevisionMismatch = sdkerrors.Register(ModuleName, 18, "whois changed since the expected revision")
+	ErrNotNameAddress   = sdkerrors.Register(ModuleName, 19, "sender is not the address the name resolves to")
 )
+
+// grpcCodes maps the module errors to the gRPC status codes clients receive
+var grpcCodes = []struct {
+	err  *sdkerrors.Error
+	code codes.Code
+}{
+	{ErrNameTaken, codes.AlreadyExists},
+	{ErrInvalidName, codes.InvalidArgument},
+	{ErrUnknownTLD, codes.InvalidArgument},
+	{ErrNotOwner, codes.PermissionDenied},
+	{ErrNameNotFound, codes.NotFound},
+	{ErrInsufficientFee, codes.FailedPrecondition},
+	{ErrNameExpired, codes.FailedPrecondition},
+	{ErrInvalidPrice, codes.InvalidArgument},
+	{ErrNotForSale, codes.FailedPrecondition},
+	{ErrPriceExceeded, codes.FailedPrecondition},
+	{ErrWhoisNotFound, codes.NotFound},
+	{ErrNoExpiry, codes.FailedPrecondition},
+	{ErrTransferNotFound, codes.NotFound},
+	{ErrNotRecipient, codes.PermissionDenied},
+	{ErrAlreadyOwner, codes.FailedPrecondition},
+	{ErrInvalidId, codes.InvalidArgument},
+	{ErrRevisionMismatch, codes.Aborted},
+	{ErrNotNameAddress, codes.PermissionDenied},
+}
+
+// GRPCCode returns the gRPC status code of the module error wrapped by err
+func GRPCCode(err error) (codes.Code, bool) {
+	for _, c := range grpcCodes {
+		if errors.Is(err, c.err) {
+			return c.code, true
+		}
+	}
+
+	return codes.Unknown, false
+}
+
+// ToGRPCError converts a module error to a gRPC status error, other errors are internal
+func ToGRPCError(err error) error {
+	code, ok := GRPCCode(err)
+	if !ok {
+		code = codes.Internal
+	}
+
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,69 @@
+package types
+
+// nameservice module event types
+//
+// The module emits the typed events defined in events.proto with EmitTypedEvent. The
+// type of an event is the full name of its proto message and every field is an
+// attribute holding the JSON encoding of its value, e.g. strings are quoted:
+//
+//	nameserviced q txs --events "enqack.nameservice.nameservice.EventWhoisCreated.name='\"alpha.wallet\"'"
+const (
+	EventTypeWhoisCreated           = "enqack.nameservice.nameservice.EventWhoisCreated"
+	EventTypeWhoisUpdated           = "enqack.nameservice.nameservice.EventWhoisUpdated"
+	EventTypeWhoisDeleted           = "enqack.nameservice.nameservice.EventWhoisDeleted"
+	EventTypeWhoisRenewed           = "enqack.nameservice.nameservice.EventWhoisRenewed"
+	EventTypeWhoisReleased          = "enqack.nameservice.nameservice.EventWhoisReleased"
+	EventTypeWhoisBought            = "enqack.nameservice.nameservice.EventWhoisBought"
+	EventTypeWhoisForSaleSet        = "enqack.nameservice.nameservice.EventWhoisForSaleSet"
+	EventTypePrimaryNameSet         = "enqack.nameservice.nameservice.EventPrimaryNameSet"
+	EventTypeWhoisTransferOffered   = "enqack.nameservice.nameservice.EventWhoisTransferOffered"
+	EventTypeWhoisTransferAccepted  = "enqack.nameservice.nameservice.EventWhoisTransferAccepted"
+	EventTypeWhoisTransferCancelled = "enqack.nameservice.nameservice.EventWhoisTransferCancelled"
+	EventTypeTldSet                 = "enqack.nameservice.nameservice.EventTldSet"
+	EventTypeFeeCharged             = "enqack.nameservice.nameservice.EventFeeCharged"
+)
+
+// nameservice module event attribute keys
+const (
+	// AttributeKeyId is the id of the whois
+	AttributeKeyId = "id"
+	// AttributeKeyName is the registered name
+	AttributeKeyName = "name"
+	// AttributeKeyOwner is the owner of the name, the previous owner once it changed hands
+	AttributeKeyOwner = "owner"
+	// AttributeKeyAddress is the address the name resolves to
+	AttributeKeyAddress = "address"
+	// AttributeKeyPrice is the price the name is listed for, or was bought for
+	AttributeKeyPrice = "price"
+	// AttributeKeyExpires is the block height at which the registration lapses
+	AttributeKeyExpires = "expires"
+	// AttributeKeyFee is the fee paid for the change
+	AttributeKeyFee = "fee"
+
+	// AttributeKeyOldName and AttributeKeyNewName are the name before and after an update
+	AttributeKeyOldName = "oldName"
+	AttributeKeyNewName = "newName"
+	// AttributeKeyOldAddress and AttributeKeyNewAddress are the target address before and after an update
+	AttributeKeyOldAddress = "oldAddress"
+	AttributeKeyNewAddress = "newAddress"
+	// AttributeKeyOldPrice and AttributeKeyNewPrice are the price before and after an update
+	AttributeKeyOldPrice = "oldPrice"
+	AttributeKeyNewPrice = "newPrice"
+
+	// AttributeKeySeller is the owner selling the name
+	AttributeKeySeller = "seller"
+	// AttributeKeyBuyer is the new owner of a bought name
+	AttributeKeyBuyer = "buyer"
+	// AttributeKeyForSale tells whether the name is listed for sale
+	AttributeKeyForSale = "forSale"
+	// AttributeKeyRecipient is the recipient of an ownership transfer
+	AttributeKeyRecipient = "recipient"
+
+	// AttributeKeyEnabled tells whether new names can be registered under a top level domain
+	AttributeKeyEnabled = "enabled"
+
+	// AttributeKeyPayer is the account charged a fee
+	AttributeKeyPayer = "payer"
+	// AttributeKeyDestination is the fee destination the fee was routed to
+	AttributeKeyDestination = "destination"
+)
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Validate checks that every fee total holds valid coins
+func (totals FeeTotals) Validate() error {
+	if err := totals.Collected.Validate(); err != nil {
+		return fmt.Errorf("collected: %w", err)
+	}
+
+	if err := totals.Burned.Validate(); err != nil {
+		return fmt.Errorf("burned: %w", err)
+	}
+
+	if err := totals.CommunityPool.Validate(); err != nil {
+		return fmt.Errorf("community pool: %w", err)
+	}
+
+	if err := totals.Treasury.Validate(); err != nil {
+		return fmt.Errorf("treasury: %w", err)
+	}
+
+	// Every fee collected went to exactly one destination
+	distributed := totals.Burned.Add(totals.CommunityPool...).Add(totals.Treasury...)
+	if totals.Collected.String() != distributed.String() {
+		return fmt.Errorf("collected %s does not match the fees burned, sent to the community pool and kept", totals.Collected)
+	}
+
+	return nil
+}
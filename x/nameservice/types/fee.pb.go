@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nameservice/fee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeTotals accumulates the fees charged by the module and where they went
+type FeeTotals struct {
+	Collected     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=collected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected"`
+	Burned        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"communityPool"`
+	Treasury      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=treasury,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"treasury"`
+}
+
+func (m *FeeTotals) Reset()         { *m = FeeTotals{} }
+func (m *FeeTotals) String() string { return proto.CompactTextString(m) }
+func (*FeeTotals) ProtoMessage()    {}
+func (*FeeTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91f880e71447cb0, []int{0}
+}
+func (m *FeeTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTotals.Merge(m, src)
+}
+func (m *FeeTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTotals proto.InternalMessageInfo
+
+func (m *FeeTotals) GetCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Collected
+	}
+	return nil
+}
+
+func (m *FeeTotals) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *FeeTotals) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *FeeTotals) GetTreasury() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Treasury
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FeeTotals)(nil), "enqack.nameservice.nameservice.FeeTotals")
+}
+
+func init() { proto.RegisterFile("nameservice/fee.proto", fileDescriptor_c91f880e71447cb0) }
+
+var fileDescriptor_c91f880e71447cb0 = []byte{
+	// 290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x31, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0x13, 0x8a, 0x2a, 0x6a, 0xc4, 0x12, 0x81, 0x14, 0x3a, 0xb8, 0x88, 0xa9, 0x0b, 0x36,
+	0x81, 0x1b, 0x14, 0x89, 0x85, 0x05, 0x21, 0x26, 0x36, 0xc7, 0x79, 0x04, 0xab, 0x89, 0x5f, 0x1b,
+	0x3b, 0x15, 0xb9, 0x05, 0xe7, 0xe0, 0x02, 0x5c, 0xa1, 0x63, 0x47, 0x26, 0x40, 0xc9, 0x45, 0x50,
+	0x93, 0x08, 0xd2, 0x3d, 0x93, 0x9f, 0xf5, 0xde, 0xff, 0x7d, 0xcb, 0x4f, 0x4e, 0xb4, 0x48, 0xc1,
+	0x40, 0xb6, 0x52, 0x12, 0xf8, 0x33, 0x00, 0x5b, 0x64, 0x68, 0xd1, 0xa3, 0xa0, 0x97, 0x42, 0xce,
+	0x59, 0x67, 0xdb, 0x9d, 0xc7, 0xc7, 0x31, 0xc6, 0x58, 0x9f, 0xf2, 0xed, 0xd4, 0xa4, 0xc6, 0x54,
+	0xa2, 0x49, 0xd1, 0xf0, 0x50, 0x18, 0xe0, 0xab, 0x20, 0x04, 0x2b, 0x02, 0x2e, 0x51, 0xe9, 0x66,
+	0x7f, 0xfe, 0x31, 0x20, 0xa3, 0x5b, 0x80, 0x47, 0xb4, 0x22, 0x31, 0x9e, 0x22, 0x23, 0x89, 0x49,
+	0x02, 0xd2, 0x42, 0xe4, 0xbb, 0x67, 0x83, 0xe9, 0xe1, 0xd5, 0x29, 0x6b, 0x08, 0x6c, 0x4b, 0x60,
+	0x2d, 0x81, 0xdd, 0xa0, 0xd2, 0xb3, 0xcb, 0xf5, 0xd7, 0xc4, 0x79, 0xff, 0x9e, 0x4c, 0x63, 0x65,
+	0x5f, 0xf2, 0x90, 0x49, 0x4c, 0x79, 0xab, 0x6b, 0x9e, 0x0b, 0x13, 0xcd, 0xb9, 0x2d, 0x16, 0x60,
+	0xea, 0x80, 0x79, 0xf8, 0xa7, 0x7b, 0x92, 0x0c, 0xc3, 0x3c, 0xd3, 0x10, 0xf9, 0x7b, 0xfd, 0x7b,
+	0x5a, 0xb4, 0xb7, 0x24, 0x47, 0x12, 0xd3, 0x34, 0xd7, 0xca, 0x16, 0xf7, 0x88, 0x89, 0x3f, 0xe8,
+	0xdf, 0xb5, 0x6b, 0xf0, 0x62, 0x72, 0x60, 0x33, 0x10, 0x26, 0xcf, 0x0a, 0x7f, 0xbf, 0x7f, 0xdb,
+	0x1f, 0x7c, 0x76, 0xb7, 0x2e, 0xa9, 0xbb, 0x29, 0xa9, 0xfb, 0x53, 0x52, 0xf7, 0xad, 0xa2, 0xce,
+	0xa6, 0xa2, 0xce, 0x67, 0x45, 0x9d, 0xa7, 0xa0, 0x43, 0x6b, 0x4a, 0xc3, 0xbb, 0x95, 0x7a, 0xdd,
+	0xf9, 0xd5, 0xf0, 0x70, 0x58, 0xb7, 0xe1, 0xfa, 0x77, 0x00, 0x1d, 0x40, 0xbe, 0x29, 0x7c, 0x02,
+	0x00, 0x00,
+}
+
+func (m *FeeTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Treasury) > 0 {
+		for iNdEx := len(m.Treasury) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Treasury[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Collected) > 0 {
+		for iNdEx := len(m.Collected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Collected) > 0 {
+		for _, e := range m.Collected {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.Treasury) > 0 {
+		for _, e := range m.Treasury {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFee(x uint64) (n int) {
+	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collected = append(m.Collected, types.Coin{})
+			if err := m.Collected[len(m.Collected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = append(m.Treasury, types.Coin{})
+			if err := m.Treasury[len(m.Treasury)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFee = fmt.Errorf("proto: unexpected end of group")
+)
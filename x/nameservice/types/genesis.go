@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
@@ -9,7 +14,11 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		WhoisList: []*Whois{},
+		WhoisList:         []*Whois{},
+		TldList:           DefaultTlds(),
+		Params:            DefaultParams(),
+		PrimaryNameList:   []*PrimaryName{},
+		WhoisTransferList: []*WhoisTransfer{},
 	}
 }
 
@@ -17,14 +26,99 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
-	// Check for duplicated ID in whois
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	if err := gs.FeeTotals.Validate(); err != nil {
+		return fmt.Errorf("invalid fee totals: %w", err)
+	}
+
+	// Check for duplicated or malformed tld
+	tldMap := make(map[string]bool)
+
+	for _, elem := range gs.TldList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if _, ok := tldMap[elem.Name]; ok {
+			return fmt.Errorf("duplicated tld %s", elem.Name)
+		}
+		tldMap[elem.Name] = true
+	}
+
+	// Check for duplicated ID and name in whois
 	whoisIdMap := make(map[string]bool)
+	whoisNameMap := make(map[string]*Whois)
 
 	for _, elem := range gs.WhoisList {
 		if _, ok := whoisIdMap[elem.Id]; ok {
 			return fmt.Errorf("duplicated id for whois")
 		}
 		whoisIdMap[elem.Id] = true
+
+		// Ids at or above the count would be handed out again by CreateWhois
+		id, err := strconv.ParseUint(elem.Id, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid whois id %s: %w", elem.Id, err)
+		}
+		if id >= gs.WhoisCount {
+			return fmt.Errorf("whois id %d should be lower than whois count %d", id, gs.WhoisCount)
+		}
+
+		if _, ok := whoisNameMap[elem.Name]; ok {
+			return fmt.Errorf("whois %s: duplicated name %s", elem.Id, elem.Name)
+		}
+		whoisNameMap[elem.Name] = elem
+
+		if err := elem.Validate(tldMap); err != nil {
+			return fmt.Errorf("whois %s: %w", elem.Id, err)
+		}
+	}
+
+	// Check that every primary name resolves to its address
+	primaryNameMap := make(map[string]bool)
+
+	for _, elem := range gs.PrimaryNameList {
+		if _, ok := primaryNameMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated primary name for %s", elem.Address)
+		}
+		primaryNameMap[elem.Address] = true
+
+		whois, ok := whoisNameMap[elem.Name]
+		if !ok {
+			return fmt.Errorf("primary name %s of %s is not registered", elem.Name, elem.Address)
+		}
+		if whois.Address != elem.Address {
+			return fmt.Errorf("primary name %s of %s resolves to %s", elem.Name, elem.Address, whois.Address)
+		}
+	}
+
+	// Check that every transfer is offered by the owner of its whois
+	transferMap := make(map[string]bool)
+
+	for _, elem := range gs.WhoisTransferList {
+		if _, ok := transferMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated transfer of whois %s", elem.Id)
+		}
+		transferMap[elem.Id] = true
+
+		whois, ok := whoisNameMap[elem.Name]
+		if !ok {
+			return fmt.Errorf("transfer of whois %s: name %s is not registered", elem.Id, elem.Name)
+		}
+		if whois.Id != elem.Id {
+			return fmt.Errorf("transfer of whois %s: name %s belongs to whois %s", elem.Id, elem.Name, whois.Id)
+		}
+		if whois.Creator != elem.Owner {
+			return fmt.Errorf("transfer of whois %s: %s doesn't own it", elem.Id, elem.Owner)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Recipient); err != nil {
+			return fmt.Errorf("transfer of whois %s: invalid recipient %s: %w", elem.Id, elem.Recipient, err)
+		}
+		if elem.Recipient == elem.Owner {
+			return fmt.Errorf("transfer of whois %s: recipient already owns it", elem.Id)
+		}
 	}
 
 	return nil
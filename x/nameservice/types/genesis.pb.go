@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -25,7 +26,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the capability module's genesis state.
 type GenesisState struct {
 	// this line is used by starport scaffolding # genesis/proto/state
-	WhoisList []*Whois `protobuf:"bytes,1,rep,name=whoisList,proto3" json:"whoisList,omitempty"`
+	WhoisList         []*Whois         `protobuf:"bytes,1,rep,name=whoisList,proto3" json:"whoisList,omitempty"`
+	TldList           []*Tld           `protobuf:"bytes,2,rep,name=tldList,proto3" json:"tldList,omitempty"`
+	Params            Params           `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	WhoisCount        uint64           `protobuf:"varint,4,opt,name=whoisCount,proto3" json:"whoisCount,omitempty"`
+	FeeTotals         FeeTotals        `protobuf:"bytes,5,opt,name=feeTotals,proto3" json:"feeTotals"`
+	PrimaryNameList   []*PrimaryName   `protobuf:"bytes,6,rep,name=primaryNameList,proto3" json:"primaryNameList,omitempty"`
+	WhoisTransferList []*WhoisTransfer `protobuf:"bytes,7,rep,name=whoisTransferList,proto3" json:"whoisTransferList,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +75,48 @@ func (m *GenesisState) GetWhoisList() []*Whois {
 	return nil
 }
 
+func (m *GenesisState) GetTldList() []*Tld {
+	if m != nil {
+		return m.TldList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetWhoisCount() uint64 {
+	if m != nil {
+		return m.WhoisCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetFeeTotals() FeeTotals {
+	if m != nil {
+		return m.FeeTotals
+	}
+	return FeeTotals{}
+}
+
+func (m *GenesisState) GetPrimaryNameList() []*PrimaryName {
+	if m != nil {
+		return m.PrimaryNameList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWhoisTransferList() []*WhoisTransfer {
+	if m != nil {
+		return m.WhoisTransferList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "enqack.nameservice.nameservice.GenesisState")
 }
@@ -75,18 +124,30 @@ func init() {
 func init() { proto.RegisterFile("nameservice/genesis.proto", fileDescriptor_d62c96c480629e8a) }
 
 var fileDescriptor_d62c96c480629e8a = []byte{
-	// 173 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0x4b, 0xcc, 0x4d,
-	0x2d, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x4b, 0xcd, 0x2b, 0x4c, 0x4c, 0xce, 0xd6, 0x43, 0x52,
-	0x81, 0xcc, 0x96, 0x12, 0x47, 0xd6, 0x5a, 0x9e, 0x91, 0x0f, 0xd3, 0xa8, 0x14, 0xcc, 0xc5, 0xe3,
-	0x0e, 0x31, 0x29, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x99, 0x8b, 0x13, 0x2c, 0xed, 0x93, 0x59,
-	0x5c, 0x22, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xaa, 0x87, 0xdf, 0x70, 0xbd, 0x70, 0x90,
-	0x86, 0x20, 0x84, 0x3e, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
-	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88,
-	0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x98, 0xaa, 0x8f,
-	0xec, 0xb2, 0x0a, 0x14, 0x5e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xa1, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xd9, 0x10, 0x27, 0xfe, 0x00, 0x00, 0x00,
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x4e, 0xea, 0x40,
+	0x18, 0xc5, 0xdb, 0x0b, 0x17, 0xc2, 0x70, 0x93, 0x1b, 0x27, 0x1a, 0x2b, 0x8b, 0x91, 0x68, 0x34,
+	0x18, 0x63, 0x1b, 0x71, 0xed, 0x06, 0x8c, 0x2e, 0xfc, 0x13, 0x53, 0x31, 0x26, 0xba, 0x1a, 0xe0,
+	0xa3, 0x34, 0xb6, 0x9d, 0xda, 0x19, 0x54, 0xde, 0xc2, 0xc7, 0x62, 0xc9, 0xd2, 0x95, 0x31, 0xf0,
+	0x18, 0x6e, 0x0c, 0xd3, 0x36, 0x8c, 0x7f, 0x62, 0xdd, 0x7d, 0x3d, 0x9d, 0xdf, 0x39, 0xe7, 0xcb,
+	0x0c, 0x5a, 0x09, 0xa8, 0x0f, 0x1c, 0xa2, 0x7b, 0xb7, 0x03, 0x96, 0x03, 0x01, 0x70, 0x97, 0x9b,
+	0x61, 0xc4, 0x04, 0xc3, 0x04, 0x82, 0x3b, 0xda, 0xb9, 0x35, 0x95, 0x13, 0xea, 0x5c, 0x59, 0x56,
+	0xd1, 0x87, 0x3e, 0x4b, 0xc1, 0xca, 0x92, 0xfa, 0x43, 0x78, 0xdd, 0x44, 0x36, 0x54, 0x39, 0xa4,
+	0x11, 0xf5, 0xbf, 0x05, 0x7a, 0x00, 0x89, 0xbc, 0xe8, 0x30, 0x87, 0xc9, 0xd1, 0x9a, 0x4d, 0xb1,
+	0xba, 0xf6, 0x96, 0x43, 0xff, 0x8e, 0xe2, 0xa2, 0x17, 0x82, 0x0a, 0xc0, 0x4d, 0x54, 0x92, 0xe9,
+	0x27, 0x2e, 0x17, 0x86, 0x5e, 0xcd, 0xd5, 0xca, 0xf5, 0x0d, 0xf3, 0xe7, 0xee, 0xe6, 0xd5, 0x0c,
+	0xb0, 0xe7, 0x1c, 0xde, 0x47, 0x45, 0xe1, 0x75, 0xa5, 0xc5, 0x1f, 0x69, 0xb1, 0x9e, 0x65, 0xd1,
+	0xf2, 0xba, 0x76, 0xca, 0xe0, 0x03, 0x54, 0x88, 0x37, 0x32, 0x72, 0x55, 0xbd, 0x56, 0xae, 0x6f,
+	0x66, 0xd1, 0xe7, 0xf2, 0x74, 0x23, 0x3f, 0x7a, 0x59, 0xd5, 0xec, 0x84, 0xc5, 0x04, 0x21, 0xd9,
+	0xa8, 0xc9, 0x06, 0x81, 0x30, 0xf2, 0x55, 0xbd, 0x96, 0xb7, 0x15, 0x05, 0x9f, 0xa2, 0x52, 0x0f,
+	0xa0, 0xc5, 0x04, 0xf5, 0xb8, 0xf1, 0x57, 0x06, 0x6d, 0x65, 0x05, 0x1d, 0xa6, 0x40, 0x92, 0x35,
+	0x77, 0xc0, 0x97, 0xe8, 0x7f, 0x18, 0xb9, 0x3e, 0x8d, 0x86, 0x67, 0xd4, 0x07, 0xb9, 0x7b, 0x41,
+	0xee, 0xbe, 0x9d, 0xd9, 0x7e, 0x8e, 0xd9, 0x9f, 0x3d, 0xf0, 0x0d, 0x5a, 0x90, 0x9d, 0x5b, 0x11,
+	0x0d, 0x78, 0x0f, 0x22, 0x69, 0x5c, 0x94, 0xc6, 0x3b, 0xbf, 0xba, 0x97, 0x14, 0xb4, 0xbf, 0xfa,
+	0x34, 0x8e, 0x47, 0x13, 0xa2, 0x8f, 0x27, 0x44, 0x7f, 0x9d, 0x10, 0xfd, 0x69, 0x4a, 0xb4, 0xf1,
+	0x94, 0x68, 0xcf, 0x53, 0xa2, 0x5d, 0xef, 0x3a, 0xae, 0xe8, 0x0f, 0xda, 0x66, 0x87, 0xf9, 0x56,
+	0x9c, 0x62, 0xa9, 0xcf, 0xea, 0xf1, 0xc3, 0x97, 0x18, 0x86, 0xc0, 0xdb, 0x05, 0xf9, 0xa2, 0xf6,
+	0xde, 0x07, 0x00, 0xbd, 0x2a, 0xdd, 0xb5, 0x05, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -109,6 +170,73 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WhoisTransferList) > 0 {
+		for iNdEx := len(m.WhoisTransferList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhoisTransferList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PrimaryNameList) > 0 {
+		for iNdEx := len(m.PrimaryNameList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrimaryNameList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.FeeTotals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.WhoisCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WhoisCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TldList) > 0 {
+		for iNdEx := len(m.TldList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TldList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.WhoisList) > 0 {
 		for iNdEx := len(m.WhoisList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -149,6 +277,31 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TldList) > 0 {
+		for _, e := range m.TldList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.WhoisCount != 0 {
+		n += 1 + sovGenesis(uint64(m.WhoisCount))
+	}
+	l = m.FeeTotals.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PrimaryNameList) > 0 {
+		for _, e := range m.PrimaryNameList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WhoisTransferList) > 0 {
+		for _, e := range m.WhoisTransferList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -221,6 +374,193 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TldList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TldList = append(m.TldList, &Tld{})
+			if err := m.TldList[len(m.TldList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhoisCount", wireType)
+			}
+			m.WhoisCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WhoisCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryNameList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryNameList = append(m.PrimaryNameList, &PrimaryName{})
+			if err := m.PrimaryNameList[len(m.PrimaryNameList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhoisTransferList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhoisTransferList = append(m.WhoisTransferList, &WhoisTransfer{})
+			if err := m.WhoisTransferList[len(m.WhoisTransferList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "nameservice"
@@ -17,11 +24,85 @@ const (
 	MemStoreKey = "mem_capability"
 )
 
-func KeyPrefix(p string) []byte {
-	return []byte(p)
-}
+// ConsensusVersion is the version of the store layout of the module, bumped by every
+// store migration
+const ConsensusVersion = 2
 
-const (
-	WhoisKey      = "Whois-value-"
-	WhoisCountKey = "Whois-count-"
+// Store layout, every key starts with a one byte prefix. Whois ids are stored as
+// big-endian uint64 so that records iterate in registration order, and the accounts
+// of the address and owner indexes are length prefixed.
+//
+//	0x01 | id                           -> Whois
+//	0x02                                -> whois count, big-endian uint64
+//	0x03 | name                         -> id
+//	0x04 | len | address | name         -> id
+//	0x05 | len | owner | name           -> id
+//	0x06 | address                      -> primary name
+//	0x07 | expires | id                 -> id
+//	0x08 | id                           -> WhoisTransfer
+//	0x09 | name                         -> Tld
+//	0x0a                                -> FeeTotals
+//	0x0b                                -> store version, big-endian uint64
+var (
+	WhoisKeyPrefix        = []byte{0x01}
+	WhoisCountKey         = []byte{0x02}
+	WhoisNameKeyPrefix    = []byte{0x03}
+	WhoisAddressKeyPrefix = []byte{0x04}
+	WhoisOwnerKeyPrefix   = []byte{0x05}
+	WhoisPrimaryKeyPrefix = []byte{0x06}
+	WhoisExpiryKeyPrefix  = []byte{0x07}
+
+	WhoisTransferKeyPrefix = []byte{0x08}
+
+	TldKeyPrefix = []byte{0x09}
+
+	FeeTotalsKey = []byte{0x0a}
+
+	StoreVersionKey = []byte{0x0b}
 )
+
+// WhoisIdKey returns the big-endian key of a whois id, the id must be a whois id
+func WhoisIdKey(id string) []byte {
+	n, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("invalid whois id %s: %s", id, err))
+	}
+	return sdk.Uint64ToBigEndian(n)
+}
+
+// WhoisIdFromKey returns the whois id of a big-endian key
+func WhoisIdFromKey(bz []byte) string {
+	return strconv.FormatUint(sdk.BigEndianToUint64(bz), 10)
+}
+
+// WhoisAddressPrefix returns the index prefix of the names resolving to address
+func WhoisAddressPrefix(address string) []byte {
+	return append(append([]byte{}, WhoisAddressKeyPrefix...), lengthPrefix([]byte(address))...)
+}
+
+// WhoisOwnerPrefix returns the index prefix of the names owned by owner
+func WhoisOwnerPrefix(owner string) []byte {
+	return append(append([]byte{}, WhoisOwnerKeyPrefix...), lengthPrefix([]byte(owner))...)
+}
+
+// SplitWhoisAccountKey splits a key of the address or owner index, without its prefix
+// byte, into the account and the name
+func SplitWhoisAccountKey(key []byte) (account string, name string, ok bool) {
+	if len(key) == 0 || len(key) < 1+int(key[0]) {
+		return "", "", false
+	}
+	return string(key[1 : 1+key[0]]), string(key[1+key[0]:]), true
+}
+
+// WhoisExpiryQueueKey returns the expiry queue key of a whois, ordered by expiry height
+func WhoisExpiryQueueKey(expires int64, id string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expires)), WhoisIdKey(id)...)
+}
+
+// lengthPrefix prefixes bz with its length so a variable length key part can't run into the next one
+func lengthPrefix(bz []byte) []byte {
+	if len(bz) > 255 {
+		panic(fmt.Sprintf("key part is longer than 255 bytes: %d", len(bz)))
+	}
+	return append([]byte{byte(len(bz))}, bz...)
+}
@@ -38,23 +38,64 @@ func (msg *MsgCreateWhois) GetSignBytes() []byte {
 }
 
 func (msg *MsgCreateWhois) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Creator)
-	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	if err := validateMsgAddress("creator", msg.Creator); err != nil {
+		return err
+	}
+
+	if err := validateMsgName(msg.Name); err != nil {
+		return err
+	}
+
+	if err := validateMsgAddress("target", msg.Address); err != nil {
+		return err
+	}
+
+	if err := ValidatePrice(msg.Price); err != nil {
+		return err
 	}
+
 	return nil
 }
 
 var _ sdk.Msg = &MsgUpdateWhois{}
 
-func NewMsgUpdateWhois(creator string, id string, name string, address string, price string) *MsgUpdateWhois {
-	return &MsgUpdateWhois{
-		Id:      id,
-		Creator: creator,
-		Name:    name,
-		Address: address,
-		Price:   price,
+// Fields of a whois MsgUpdateWhois can update
+const (
+	UpdateMaskName    = "name"
+	UpdateMaskAddress = "address"
+	UpdateMaskPrice   = "price"
+)
+
+// NewMsgUpdateWhois creates a MsgUpdateWhois for the whois registered under the name whois,
+// or with the id whois
+func NewMsgUpdateWhois(creator string, whois string, name string, address string, price string, updateMask []string, expectedRevision uint64) *MsgUpdateWhois {
+	msg := &MsgUpdateWhois{
+		Creator:          creator,
+		Name:             name,
+		Address:          address,
+		Price:            price,
+		UpdateMask:       updateMask,
+		ExpectedRevision: expectedRevision,
+	}
+	if IsWhoisId(whois) {
+		msg.Id = whois
+	} else {
+		msg.CurrentName = whois
+	}
+	return msg
+}
+
+// UpdatePaths returns the fields the message updates, an empty mask updates all of them
+func (msg *MsgUpdateWhois) UpdatePaths() map[string]bool {
+	if len(msg.UpdateMask) == 0 {
+		return map[string]bool{UpdateMaskName: true, UpdateMaskAddress: true, UpdateMaskPrice: true}
+	}
+
+	paths := make(map[string]bool, len(msg.UpdateMask))
+	for _, path := range msg.UpdateMask {
+		paths[path] = true
 	}
+	return paths
 }
 
 func (msg *MsgUpdateWhois) Route() string {
@@ -79,20 +120,66 @@ func (msg *MsgUpdateWhois) GetSignBytes() []byte {
 }
 
 func (msg *MsgUpdateWhois) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Creator)
-	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	if err := validateMsgAddress("creator", msg.Creator); err != nil {
+		return err
+	}
+
+	if err := validateMsgWhois(msg.CurrentName, msg.Id); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(msg.UpdateMask))
+	for _, path := range msg.UpdateMask {
+		switch path {
+		case UpdateMaskName, UpdateMaskAddress, UpdateMaskPrice:
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown update mask field %s", path)
+		}
+
+		if seen[path] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated update mask field %s", path)
+		}
+		seen[path] = true
+	}
+
+	// Fields outside of the mask are ignored
+	paths := msg.UpdatePaths()
+
+	if paths[UpdateMaskName] {
+		if err := validateMsgName(msg.Name); err != nil {
+			return err
+		}
+	}
+
+	if paths[UpdateMaskAddress] {
+		if err := validateMsgAddress("target", msg.Address); err != nil {
+			return err
+		}
+	}
+
+	if paths[UpdateMaskPrice] {
+		if err := ValidatePrice(msg.Price); err != nil {
+			return err
+		}
 	}
+
 	return nil
 }
 
-var _ sdk.Msg = &MsgCreateWhois{}
+var _ sdk.Msg = &MsgDeleteWhois{}
 
-func NewMsgDeleteWhois(creator string, id string) *MsgDeleteWhois {
-	return &MsgDeleteWhois{
-		Id:      id,
+// NewMsgDeleteWhois creates a MsgDeleteWhois for the whois registered under the name whois,
+// or with the id whois
+func NewMsgDeleteWhois(creator string, whois string) *MsgDeleteWhois {
+	msg := &MsgDeleteWhois{
 		Creator: creator,
 	}
+	if IsWhoisId(whois) {
+		msg.Id = whois
+	} else {
+		msg.Name = whois
+	}
+	return msg
 }
 func (msg *MsgDeleteWhois) Route() string {
 	return RouterKey
@@ -116,9 +203,354 @@ func (msg *MsgDeleteWhois) GetSignBytes() []byte {
 }
 
 func (msg *MsgDeleteWhois) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err := validateMsgAddress("creator", msg.Creator); err != nil {
+		return err
+	}
+
+	if err := validateMsgWhois(msg.Name, msg.Id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetPrimaryName{}
+
+func NewMsgSetPrimaryName(creator string, name string) *MsgSetPrimaryName {
+	return &MsgSetPrimaryName{
+		Creator: creator,
+		Name:    name,
+	}
+}
+
+func (msg *MsgSetPrimaryName) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPrimaryName) Type() string {
+	return "SetPrimaryName"
+}
+
+func (msg *MsgSetPrimaryName) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetPrimaryName) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetPrimaryName) ValidateBasic() error {
+	if err := validateMsgAddress("creator", msg.Creator); err != nil {
+		return err
+	}
+
+	if err := validateMsgName(msg.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgRenewWhois{}
+
+func NewMsgRenewWhois(creator string, name string) *MsgRenewWhois {
+	return &MsgRenewWhois{
+		Creator: creator,
+		Name:    name,
+	}
+}
+
+func (msg *MsgRenewWhois) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRenewWhois) Type() string {
+	return "RenewWhois"
+}
+
+func (msg *MsgRenewWhois) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRenewWhois) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRenewWhois) ValidateBasic() error {
+	if err := validateMsgAddress("creator", msg.Creator); err != nil {
+		return err
+	}
+
+	if err := validateMsgName(msg.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgBuyWhois{}
+
+func NewMsgBuyWhois(buyer string, name string, maxPrice string) *MsgBuyWhois {
+	return &MsgBuyWhois{
+		Buyer:    buyer,
+		Name:     name,
+		MaxPrice: maxPrice,
+	}
+}
+
+func (msg *MsgBuyWhois) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBuyWhois) Type() string {
+	return "BuyWhois"
+}
+
+func (msg *MsgBuyWhois) GetSigners() []sdk.AccAddress {
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{buyer}
+}
+
+func (msg *MsgBuyWhois) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBuyWhois) ValidateBasic() error {
+	if err := validateMsgAddress("buyer", msg.Buyer); err != nil {
+		return err
+	}
+
+	if err := validateMsgName(msg.Name); err != nil {
+		return err
+	}
+
+	if err := ValidatePrice(msg.MaxPrice); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetWhoisForSale{}
+
+func NewMsgSetWhoisForSale(creator string, name string, forSale bool) *MsgSetWhoisForSale {
+	return &MsgSetWhoisForSale{
+		Creator: creator,
+		Name:    name,
+		ForSale: forSale,
+	}
+}
+
+func (msg *MsgSetWhoisForSale) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetWhoisForSale) Type() string {
+	return "SetWhoisForSale"
+}
+
+func (msg *MsgSetWhoisForSale) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetWhoisForSale) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetWhoisForSale) ValidateBasic() error {
+	if err := validateMsgAddress("creator", msg.Creator); err != nil {
+		return err
+	}
+
+	if err := validateMsgName(msg.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgTransferWhois{}
+
+func NewMsgTransferWhois(creator string, name string, recipient string) *MsgTransferWhois {
+	return &MsgTransferWhois{
+		Creator:   creator,
+		Name:      name,
+		Recipient: recipient,
+	}
+}
+
+func (msg *MsgTransferWhois) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferWhois) Type() string {
+	return "TransferWhois"
+}
+
+func (msg *MsgTransferWhois) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferWhois) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferWhois) ValidateBasic() error {
+	if err := validateMsgAddress("creator", msg.Creator); err != nil {
+		return err
+	}
+
+	if err := validateMsgName(msg.Name); err != nil {
+		return err
+	}
+
+	if err := validateMsgAddress("recipient", msg.Recipient); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgAcceptWhoisTransfer{}
+
+func NewMsgAcceptWhoisTransfer(creator string, name string) *MsgAcceptWhoisTransfer {
+	return &MsgAcceptWhoisTransfer{
+		Creator: creator,
+		Name:    name,
+	}
+}
+
+func (msg *MsgAcceptWhoisTransfer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptWhoisTransfer) Type() string {
+	return "AcceptWhoisTransfer"
+}
+
+func (msg *MsgAcceptWhoisTransfer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptWhoisTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptWhoisTransfer) ValidateBasic() error {
+	if err := validateMsgAddress("creator", msg.Creator); err != nil {
+		return err
+	}
+
+	if err := validateMsgName(msg.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgCancelWhoisTransfer{}
+
+func NewMsgCancelWhoisTransfer(creator string, name string) *MsgCancelWhoisTransfer {
+	return &MsgCancelWhoisTransfer{
+		Creator: creator,
+		Name:    name,
+	}
+}
+
+func (msg *MsgCancelWhoisTransfer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelWhoisTransfer) Type() string {
+	return "CancelWhoisTransfer"
+}
+
+func (msg *MsgCancelWhoisTransfer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelWhoisTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelWhoisTransfer) ValidateBasic() error {
+	if err := validateMsgAddress("creator", msg.Creator); err != nil {
+		return err
+	}
+
+	if err := validateMsgName(msg.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMsgAddress checks a bech32 address field of a message
+func validateMsgAddress(field string, address string) error {
+	if err := ValidateAddress(address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid %s address (%s)", field, err)
+	}
+	return nil
+}
+
+// validateMsgName checks the syntax of a name, whether its top level domain is enabled is up to the handler
+func validateMsgName(name string) error {
+	if _, err := ValidateName(name); err != nil {
+		return sdkerrors.Wrap(ErrInvalidName, err.Error())
+	}
+	return nil
+}
+
+// validateMsgWhois checks the whois a message addresses, by name or by id for older clients
+func validateMsgWhois(name string, id string) error {
+	if name == "" {
+		return validateMsgId(id)
+	}
+
+	if id != "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "whois can't be addressed by both name and id")
+	}
+	return validateMsgName(name)
+}
+
+// validateMsgId checks the whois id of a message
+func validateMsgId(id string) error {
+	if err := ValidateId(id); err != nil {
+		return sdkerrors.Wrap(ErrInvalidId, err.Error())
 	}
 	return nil
 }
@@ -6,43 +6,82 @@ import (
 
 	yaml "gopkg.in/yaml.v2"
 
-	//sdk "github.com/cosmos/cosmos-sdk/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// Default whois prices
+var (
+	DefaultCreateWhoisPrice = sdk.NewCoins(sdk.NewInt64Coin("trycoin", 10))
+	DefaultUpdateWhoisPrice = sdk.NewCoins(sdk.NewInt64Coin("trycoin", 5))
+	DefaultDeleteWhoisPrice = sdk.NewCoins(sdk.NewInt64Coin("trycoin", 1))
+	DefaultRenewWhoisPrice  = sdk.NewCoins(sdk.NewInt64Coin("trycoin", 10))
+)
+
 const (
-	DefaultCreateWhoisPrice string = "10trycoin"
-	DefaultUpdateWhoisPrice string = "5trycoin"
-	DefaultDeleteWhoisPrice string = "1trycoin"
+	// DefaultRegistrationPeriod is roughly one year of 6 second blocks
+	DefaultRegistrationPeriod int64 = 5256000
+	// DefaultExpiryGracePeriod is roughly four weeks of 6 second blocks
+	DefaultExpiryGracePeriod int64 = 403200
+	// DefaultMaxExpirationsPerBlock bounds the names released in a single EndBlock
+	DefaultMaxExpirationsPerBlock uint64 = 100
+	// DefaultFeeDestination burns the fees charged by the module
+	DefaultFeeDestination string = FeeDestinationBurn
+)
+
+// Fee destinations
+const (
+	FeeDestinationBurn          string = "burn"
+	FeeDestinationCommunityPool string = "community_pool"
+	FeeDestinationTreasury      string = "treasury"
 )
 
 // Parameter keys
 var (
-	KeyCreateWhoisPrice = []byte("CreateWhoisPrice")
-	KeyUpdateWhoisPrice = []byte("UpdateWhoisPrice")
-	KeyDeleteWhoisPrice = []byte("DeleteWhoisPrice")
+	KeyCreateWhoisPrice       = []byte("CreateWhoisPrice")
+	KeyUpdateWhoisPrice       = []byte("UpdateWhoisPrice")
+	KeyDeleteWhoisPrice       = []byte("DeleteWhoisPrice")
+	KeyRenewWhoisPrice        = []byte("RenewWhoisPrice")
+	KeyRegistrationPeriod     = []byte("RegistrationPeriod")
+	KeyExpiryGracePeriod      = []byte("ExpiryGracePeriod")
+	KeyMaxExpirationsPerBlock = []byte("MaxExpirationsPerBlock")
+	KeyTldPrices              = []byte("TldPrices")
+	KeyLengthPrices           = []byte("LengthPrices")
+	KeyFeeDestination         = []byte("FeeDestination")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
-// Params return all of the whois params
-type Params struct {
-	CreateWhoisPrice string `json:"minimum_create_whois_price" yaml:"minimum_create_whois_price"`
-	UpdateWhoisPrice string `json:"update_whois_price" yaml:"update_whois_price"`
-	DeleteWhoisPrice string `json:"delete_whois_price" yaml:"delete_whois_price"`
-}
-
 // ParamKeyTable returns the parameter key table.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams(createWhoisPrice string, updateWhoisPrice string, deleteWhoisPrice string) Params {
+func NewParams(
+	createWhoisPrice sdk.Coins, updateWhoisPrice sdk.Coins, deleteWhoisPrice sdk.Coins, renewWhoisPrice sdk.Coins,
+	registrationPeriod int64, expiryGracePeriod int64, maxExpirationsPerBlock uint64,
+	tldPrices []TldPrice, lengthPrices []LengthPrice, feeDestination string,
+) Params {
 	return Params{
-		CreateWhoisPrice: createWhoisPrice,
-		UpdateWhoisPrice: updateWhoisPrice,
-		DeleteWhoisPrice: deleteWhoisPrice,
+		CreateWhoisPrice:       createWhoisPrice,
+		UpdateWhoisPrice:       updateWhoisPrice,
+		DeleteWhoisPrice:       deleteWhoisPrice,
+		RenewWhoisPrice:        renewWhoisPrice,
+		RegistrationPeriod:     registrationPeriod,
+		ExpiryGracePeriod:      expiryGracePeriod,
+		MaxExpirationsPerBlock: maxExpirationsPerBlock,
+		TldPrices:              tldPrices,
+		LengthPrices:           lengthPrices,
+		FeeDestination:         feeDestination,
+	}
+}
+
+// DefaultLengthPrices returns the default premiums of short names
+func DefaultLengthPrices() []LengthPrice {
+	return []LengthPrice{
+		{MaxLength: 3, Price: sdk.NewCoins(sdk.NewInt64Coin("trycoin", 100))},
+		{MaxLength: 5, Price: sdk.NewCoins(sdk.NewInt64Coin("trycoin", 20))},
 	}
 }
 
@@ -52,6 +91,13 @@ func DefaultParams() Params {
 		DefaultCreateWhoisPrice,
 		DefaultUpdateWhoisPrice,
 		DefaultDeleteWhoisPrice,
+		DefaultRenewWhoisPrice,
+		DefaultRegistrationPeriod,
+		DefaultExpiryGracePeriod,
+		DefaultMaxExpirationsPerBlock,
+		[]TldPrice{},
+		DefaultLengthPrices(),
+		DefaultFeeDestination,
 	)
 }
 
@@ -66,6 +112,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyCreateWhoisPrice, &p.CreateWhoisPrice, validateCreateWhoisPrice),
 		paramtypes.NewParamSetPair(KeyUpdateWhoisPrice, &p.UpdateWhoisPrice, validateUpdateWhoisPrice),
 		paramtypes.NewParamSetPair(KeyDeleteWhoisPrice, &p.DeleteWhoisPrice, validateDeleteWhoisPrice),
+		paramtypes.NewParamSetPair(KeyRenewWhoisPrice, &p.RenewWhoisPrice, validateRenewWhoisPrice),
+		paramtypes.NewParamSetPair(KeyRegistrationPeriod, &p.RegistrationPeriod, validateRegistrationPeriod),
+		paramtypes.NewParamSetPair(KeyExpiryGracePeriod, &p.ExpiryGracePeriod, validateExpiryGracePeriod),
+		paramtypes.NewParamSetPair(KeyMaxExpirationsPerBlock, &p.MaxExpirationsPerBlock, validateMaxExpirationsPerBlock),
+		paramtypes.NewParamSetPair(KeyTldPrices, &p.TldPrices, validateTldPrices),
+		paramtypes.NewParamSetPair(KeyLengthPrices, &p.LengthPrices, validateLengthPrices),
+		paramtypes.NewParamSetPair(KeyFeeDestination, &p.FeeDestination, validateFeeDestination),
 	}
 }
 
@@ -83,44 +136,185 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateRenewWhoisPrice(p.RenewWhoisPrice); err != nil {
+		return err
+	}
+
+	if err := validateRegistrationPeriod(p.RegistrationPeriod); err != nil {
+		return err
+	}
+
+	if err := validateExpiryGracePeriod(p.ExpiryGracePeriod); err != nil {
+		return err
+	}
+
+	if err := validateMaxExpirationsPerBlock(p.MaxExpirationsPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateTldPrices(p.TldPrices); err != nil {
+		return err
+	}
+
+	if err := validateLengthPrices(p.LengthPrices); err != nil {
+		return err
+	}
+
+	if err := validateFeeDestination(p.FeeDestination); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func validateCreateWhoisPrice(i interface{}) error {
-	v, ok := i.(string)
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return validatePrice("create whois price", v)
+}
+
+func validateUpdateWhoisPrice(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return validatePrice("update whois price", v)
+}
+
+func validateDeleteWhoisPrice(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return validatePrice("delete whois price", v)
+}
+
+func validateRenewWhoisPrice(i interface{}) error {
+	v, ok := i.(sdk.Coins)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if len(v) == 0 {
-		return errors.New("create whois price cannot be empty")
+	return validatePrice("renew whois price", v)
+}
+
+// validatePrice checks that a price holds at least one coin and is sorted with positive amounts
+func validatePrice(name string, price sdk.Coins) error {
+	if price.Empty() {
+		return fmt.Errorf("%s cannot be empty", name)
+	}
+
+	if err := price.Validate(); err != nil {
+		return fmt.Errorf("invalid %s: %w", name, err)
 	}
 
 	return nil
 }
 
-func validateUpdateWhoisPrice(i interface{}) error {
-	v, ok := i.(string)
+func validateRegistrationPeriod(i interface{}) error {
+	v, ok := i.(int64)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if len(v) == 0 {
-		return errors.New("update whois price cannot be empty")
+	if v < 0 {
+		return fmt.Errorf("registration period cannot be negative: %d", v)
 	}
 
 	return nil
 }
 
-func validateDeleteWhoisPrice(i interface{}) error {
-	v, ok := i.(string)
+func validateExpiryGracePeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("expiry grace period cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxExpirationsPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("max expirations per block must be positive")
+	}
+
+	return nil
+}
+
+func validateTldPrices(i interface{}) error {
+	v, ok := i.([]TldPrice)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if len(v) == 0 {
-		return errors.New("delete whois price cannot be empty")
+	seen := make(map[string]bool)
+	for _, tp := range v {
+		if err := (Tld{Name: tp.Tld}).Validate(); err != nil {
+			return err
+		}
+
+		if seen[tp.Tld] {
+			return fmt.Errorf("duplicated tld price for %s", tp.Tld)
+		}
+		seen[tp.Tld] = true
+
+		if err := validatePrice(fmt.Sprintf("tld price for %s", tp.Tld), tp.Price); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+func validateLengthPrices(i interface{}) error {
+	v, ok := i.([]LengthPrice)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[uint64]bool)
+	for _, lp := range v {
+		if lp.MaxLength == 0 {
+			return errors.New("length price max length must be positive")
+		}
+
+		if seen[lp.MaxLength] {
+			return fmt.Errorf("duplicated length price for max length %d", lp.MaxLength)
+		}
+		seen[lp.MaxLength] = true
+
+		if err := validatePrice(fmt.Sprintf("length price for max length %d", lp.MaxLength), lp.Price); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateFeeDestination(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case FeeDestinationBurn, FeeDestinationCommunityPool, FeeDestinationTreasury:
+		return nil
+	default:
+		return fmt.Errorf("invalid fee destination: %s", v)
+	}
+}
@@ -0,0 +1,1281 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nameservice/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the nameservice module
+type Params struct {
+	CreateWhoisPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=createWhoisPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"createWhoisPrice" yaml:"create_whois_price"`
+	// updateWhoisPrice is charged for every update, renames also pay the registration cost of the new name
+	UpdateWhoisPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=updateWhoisPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"updateWhoisPrice" yaml:"update_whois_price"`
+	DeleteWhoisPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deleteWhoisPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deleteWhoisPrice" yaml:"delete_whois_price"`
+	// renewWhoisPrice is charged for every registration period a whois is renewed
+	RenewWhoisPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=renewWhoisPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"renewWhoisPrice" yaml:"renew_whois_price"`
+	// registrationPeriod is the number of blocks a registration lasts, 0 disables expiry
+	RegistrationPeriod int64 `protobuf:"varint,5,opt,name=registrationPeriod,proto3" json:"registrationPeriod,omitempty" yaml:"registration_period"`
+	// expiryGracePeriod is the number of blocks an expired whois can still be renewed
+	ExpiryGracePeriod      int64  `protobuf:"varint,6,opt,name=expiryGracePeriod,proto3" json:"expiryGracePeriod,omitempty" yaml:"expiry_grace_period"`
+	MaxExpirationsPerBlock uint64 `protobuf:"varint,7,opt,name=maxExpirationsPerBlock,proto3" json:"maxExpirationsPerBlock,omitempty" yaml:"max_expirations_per_block"`
+	// tldPrices replace createWhoisPrice for names under the listed top level domains
+	TldPrices []TldPrice `protobuf:"bytes,8,rep,name=tldPrices,proto3" json:"tldPrices" yaml:"tld_prices"`
+	// lengthPrices are premiums added for short labels, the tier with the smallest matching maxLength applies
+	LengthPrices []LengthPrice `protobuf:"bytes,9,rep,name=lengthPrices,proto3" json:"lengthPrices" yaml:"length_prices"`
+	// feeDestination is where the fees collected by the module account go: burn, community_pool or treasury
+	FeeDestination string `protobuf:"bytes,10,opt,name=feeDestination,proto3" json:"feeDestination,omitempty" yaml:"fee_destination"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9347ee334d773820, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetCreateWhoisPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreateWhoisPrice
+	}
+	return nil
+}
+
+func (m *Params) GetUpdateWhoisPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UpdateWhoisPrice
+	}
+	return nil
+}
+
+func (m *Params) GetDeleteWhoisPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DeleteWhoisPrice
+	}
+	return nil
+}
+
+func (m *Params) GetRenewWhoisPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RenewWhoisPrice
+	}
+	return nil
+}
+
+func (m *Params) GetRegistrationPeriod() int64 {
+	if m != nil {
+		return m.RegistrationPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetExpiryGracePeriod() int64 {
+	if m != nil {
+		return m.ExpiryGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExpirationsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxExpirationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetTldPrices() []TldPrice {
+	if m != nil {
+		return m.TldPrices
+	}
+	return nil
+}
+
+func (m *Params) GetLengthPrices() []LengthPrice {
+	if m != nil {
+		return m.LengthPrices
+	}
+	return nil
+}
+
+func (m *Params) GetFeeDestination() string {
+	if m != nil {
+		return m.FeeDestination
+	}
+	return ""
+}
+
+// TldPrice is the registration price of names under a top level domain
+type TldPrice struct {
+	Tld   string                                   `protobuf:"bytes,1,opt,name=tld,proto3" json:"tld,omitempty"`
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+}
+
+func (m *TldPrice) Reset()         { *m = TldPrice{} }
+func (m *TldPrice) String() string { return proto.CompactTextString(m) }
+func (*TldPrice) ProtoMessage()    {}
+func (*TldPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9347ee334d773820, []int{1}
+}
+func (m *TldPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TldPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TldPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TldPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TldPrice.Merge(m, src)
+}
+func (m *TldPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *TldPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_TldPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TldPrice proto.InternalMessageInfo
+
+func (m *TldPrice) GetTld() string {
+	if m != nil {
+		return m.Tld
+	}
+	return ""
+}
+
+func (m *TldPrice) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+// LengthPrice is the premium charged for labels of at most maxLength characters
+type LengthPrice struct {
+	MaxLength uint64                                   `protobuf:"varint,1,opt,name=maxLength,proto3" json:"max_length,omitempty" yaml:"max_length"`
+	Price     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+}
+
+func (m *LengthPrice) Reset()         { *m = LengthPrice{} }
+func (m *LengthPrice) String() string { return proto.CompactTextString(m) }
+func (*LengthPrice) ProtoMessage()    {}
+func (*LengthPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9347ee334d773820, []int{2}
+}
+func (m *LengthPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LengthPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LengthPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LengthPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LengthPrice.Merge(m, src)
+}
+func (m *LengthPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *LengthPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_LengthPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LengthPrice proto.InternalMessageInfo
+
+func (m *LengthPrice) GetMaxLength() uint64 {
+	if m != nil {
+		return m.MaxLength
+	}
+	return 0
+}
+
+func (m *LengthPrice) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "enqack.nameservice.nameservice.Params")
+	proto.RegisterType((*TldPrice)(nil), "enqack.nameservice.nameservice.TldPrice")
+	proto.RegisterType((*LengthPrice)(nil), "enqack.nameservice.nameservice.LengthPrice")
+}
+
+func init() { proto.RegisterFile("nameservice/params.proto", fileDescriptor_9347ee334d773820) }
+
+var fileDescriptor_9347ee334d773820 = []byte{
+	// 635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x14, 0xc7, 0xe3, 0x5f, 0xd2, 0xfe, 0x9a, 0x2b, 0x82, 0xf6, 0x54, 0x55, 0x6e, 0x84, 0xec, 0xc8,
+	0x62, 0x88, 0x04, 0xb5, 0x29, 0x6c, 0x1d, 0x0d, 0x88, 0xa1, 0x05, 0x45, 0x16, 0x12, 0x12, 0x42,
+	0xb2, 0x2e, 0xf6, 0x6b, 0x6a, 0xd5, 0xf6, 0x19, 0xdf, 0xb5, 0x4d, 0x26, 0xfe, 0x05, 0x90, 0x18,
+	0x18, 0x99, 0xf9, 0x1f, 0xd8, 0x3b, 0x76, 0x64, 0x0a, 0xa8, 0xdd, 0x18, 0xb3, 0xb1, 0xa1, 0xbb,
+	0x73, 0x6b, 0x27, 0x05, 0x4a, 0xa8, 0xc4, 0x94, 0x8b, 0xfd, 0xde, 0xe7, 0xfb, 0xf1, 0x3d, 0x5b,
+	0x87, 0xf4, 0x94, 0x24, 0xc0, 0x20, 0x3f, 0x88, 0x02, 0x70, 0x32, 0x92, 0x93, 0x84, 0xd9, 0x59,
+	0x4e, 0x39, 0xc5, 0x06, 0xa4, 0xaf, 0x48, 0xb0, 0x67, 0x57, 0x0a, 0xaa, 0xeb, 0xd6, 0x4a, 0x9f,
+	0xf6, 0xa9, 0x2c, 0x75, 0xc4, 0x4a, 0x75, 0xb5, 0x8c, 0x80, 0xb2, 0x84, 0x32, 0xa7, 0x47, 0x18,
+	0x38, 0x07, 0x1b, 0x3d, 0xe0, 0x64, 0xc3, 0x09, 0x68, 0x94, 0xaa, 0xfb, 0xd6, 0xf7, 0x05, 0x34,
+	0xdf, 0x95, 0x31, 0xf8, 0x9d, 0x86, 0x96, 0x82, 0x1c, 0x08, 0x87, 0xe7, 0xbb, 0x34, 0x62, 0xdd,
+	0x3c, 0x0a, 0x40, 0xd7, 0xda, 0xf5, 0xce, 0xe2, 0xbd, 0x35, 0x5b, 0x61, 0x6c, 0x81, 0xb1, 0x0b,
+	0x8c, 0xfd, 0x80, 0x46, 0xa9, 0xfb, 0xe4, 0x68, 0x64, 0xd6, 0xc6, 0x23, 0x73, 0x6d, 0x48, 0x92,
+	0x78, 0xd3, 0x52, 0x00, 0xff, 0x50, 0x10, 0xfc, 0x4c, 0x20, 0xac, 0x8f, 0x5f, 0xcc, 0x4e, 0x3f,
+	0xe2, 0xbb, 0xfb, 0x3d, 0x3b, 0xa0, 0x89, 0x53, 0x08, 0xa9, 0x9f, 0x75, 0x16, 0xee, 0x39, 0x7c,
+	0x98, 0x01, 0x93, 0x34, 0xe6, 0x5d, 0x30, 0x90, 0x5a, 0xfb, 0x59, 0x38, 0xa9, 0xf5, 0xdf, 0x8c,
+	0x5a, 0x0a, 0x70, 0x05, 0xad, 0x69, 0x03, 0xa9, 0x15, 0x42, 0x0c, 0x13, 0x5a, 0xf5, 0x19, 0xb5,
+	0x14, 0xe0, 0x0a, 0x5a, 0xd3, 0x06, 0xf8, 0xad, 0x86, 0x6e, 0xe4, 0x90, 0xc2, 0x61, 0xc5, 0xaa,
+	0x71, 0x99, 0xd5, 0x76, 0x61, 0xa5, 0x2b, 0x2b, 0xd9, 0xff, 0xf7, 0x52, 0xd3, 0xf9, 0xf8, 0x29,
+	0xc2, 0x39, 0xf4, 0x23, 0xc6, 0x73, 0xc2, 0x23, 0x9a, 0x76, 0x21, 0x8f, 0x68, 0xa8, 0xcf, 0xb5,
+	0xb5, 0x4e, 0xdd, 0x35, 0xc6, 0x23, 0xb3, 0x75, 0x16, 0x5b, 0xd6, 0xf8, 0x99, 0x2c, 0xb2, 0xbc,
+	0x9f, 0x74, 0xe2, 0x6d, 0xb4, 0x0c, 0x83, 0x2c, 0xca, 0x87, 0x8f, 0x73, 0x12, 0x40, 0x81, 0x9b,
+	0x9f, 0xc6, 0xa9, 0x12, 0xbf, 0x2f, 0x6a, 0xce, 0x71, 0x17, 0x1b, 0xf1, 0x4b, 0xb4, 0x9a, 0x90,
+	0xc1, 0x23, 0x71, 0x5d, 0x86, 0xb0, 0x2e, 0xe4, 0x6e, 0x4c, 0x83, 0x3d, 0xfd, 0xff, 0xb6, 0xd6,
+	0x69, 0xb8, 0xb7, 0xc6, 0x23, 0xb3, 0xad, 0x90, 0x09, 0x19, 0xf8, 0x50, 0x16, 0x0a, 0xaa, 0xdf,
+	0x13, 0xa5, 0x96, 0xf7, 0x0b, 0x06, 0x26, 0xa8, 0xc9, 0xe3, 0x50, 0xee, 0x03, 0xd3, 0x17, 0xe4,
+	0x20, 0x3a, 0xf6, 0xef, 0xbf, 0x64, 0xfb, 0x59, 0xd1, 0xe0, 0xae, 0x15, 0x73, 0x59, 0x56, 0xf1,
+	0x3c, 0x0e, 0xd5, 0x3c, 0x98, 0xe5, 0x95, 0x54, 0x9c, 0xa0, 0x6b, 0x31, 0xa4, 0x7d, 0xbe, 0x5b,
+	0xa4, 0x34, 0x65, 0xca, 0xed, 0xcb, 0x52, 0xb6, 0xcb, 0x1e, 0xf7, 0x66, 0x11, 0xb4, 0xa2, 0x82,
+	0x14, 0xee, 0x3c, 0x6b, 0x02, 0x8f, 0x5d, 0x74, 0x7d, 0x07, 0xe0, 0x21, 0x30, 0x1e, 0xa5, 0xf2,
+	0x61, 0x75, 0xd4, 0xd6, 0x3a, 0x4d, 0xb7, 0x35, 0x1e, 0x99, 0xab, 0xaa, 0x7f, 0x07, 0xc0, 0x0f,
+	0xcb, 0x02, 0xcb, 0x9b, 0xea, 0xd8, 0x6c, 0xbc, 0xff, 0x60, 0xd6, 0xac, 0xd7, 0x68, 0xe1, 0xec,
+	0x51, 0xf1, 0x12, 0xaa, 0xf3, 0x38, 0xd4, 0x35, 0x81, 0xf2, 0xc4, 0x12, 0x13, 0x34, 0x97, 0xfd,
+	0xd9, 0xb7, 0x7e, 0x57, 0xd8, 0xcf, 0xf4, 0x8a, 0x2a, 0xb2, 0xf5, 0x49, 0x43, 0x8b, 0x95, 0x6d,
+	0xc0, 0x5b, 0xa8, 0x99, 0x90, 0x81, 0xba, 0x22, 0x55, 0x1a, 0xee, 0xfa, 0xb7, 0x91, 0xb9, 0x22,
+	0xe6, 0xae, 0xf6, 0xe0, 0x0e, 0x4d, 0x22, 0x0e, 0x49, 0xc6, 0x87, 0xe5, 0x58, 0xca, 0xbb, 0x96,
+	0x57, 0xf6, 0xff, 0x03, 0x7f, 0x77, 0xeb, 0xe8, 0xc4, 0xd0, 0x8e, 0x4f, 0x0c, 0xed, 0xeb, 0x89,
+	0xa1, 0xbd, 0x39, 0x35, 0x6a, 0xc7, 0xa7, 0x46, 0xed, 0xf3, 0xa9, 0x51, 0x7b, 0xb1, 0x51, 0x41,
+	0xa9, 0xf7, 0xc0, 0xa9, 0x1e, 0x2c, 0x83, 0x89, 0x7f, 0x92, 0xdc, 0x9b, 0x97, 0x07, 0xc2, 0xfd,
+	0x1f, 0x03, 0x00, 0xa5, 0x62, 0xb4, 0xe3, 0x82, 0x06, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeDestination) > 0 {
+		i -= len(m.FeeDestination)
+		copy(dAtA[i:], m.FeeDestination)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeDestination)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.LengthPrices) > 0 {
+		for iNdEx := len(m.LengthPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LengthPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TldPrices) > 0 {
+		for iNdEx := len(m.TldPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TldPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxExpirationsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExpirationsPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiryGracePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExpiryGracePeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RegistrationPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RegistrationPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RenewWhoisPrice) > 0 {
+		for iNdEx := len(m.RenewWhoisPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RenewWhoisPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DeleteWhoisPrice) > 0 {
+		for iNdEx := len(m.DeleteWhoisPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeleteWhoisPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UpdateWhoisPrice) > 0 {
+		for iNdEx := len(m.UpdateWhoisPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateWhoisPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CreateWhoisPrice) > 0 {
+		for iNdEx := len(m.CreateWhoisPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateWhoisPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TldPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TldPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TldPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Tld) > 0 {
+		i -= len(m.Tld)
+		copy(dAtA[i:], m.Tld)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Tld)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LengthPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LengthPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LengthPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CreateWhoisPrice) > 0 {
+		for _, e := range m.CreateWhoisPrice {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.UpdateWhoisPrice) > 0 {
+		for _, e := range m.UpdateWhoisPrice {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DeleteWhoisPrice) > 0 {
+		for _, e := range m.DeleteWhoisPrice {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RenewWhoisPrice) > 0 {
+		for _, e := range m.RenewWhoisPrice {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.RegistrationPeriod != 0 {
+		n += 1 + sovParams(uint64(m.RegistrationPeriod))
+	}
+	if m.ExpiryGracePeriod != 0 {
+		n += 1 + sovParams(uint64(m.ExpiryGracePeriod))
+	}
+	if m.MaxExpirationsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxExpirationsPerBlock))
+	}
+	if len(m.TldPrices) > 0 {
+		for _, e := range m.TldPrices {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.LengthPrices) > 0 {
+		for _, e := range m.LengthPrices {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.FeeDestination)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *TldPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tld)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LengthPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxLength))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateWhoisPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateWhoisPrice = append(m.CreateWhoisPrice, types.Coin{})
+			if err := m.CreateWhoisPrice[len(m.CreateWhoisPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateWhoisPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateWhoisPrice = append(m.UpdateWhoisPrice, types.Coin{})
+			if err := m.UpdateWhoisPrice[len(m.UpdateWhoisPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteWhoisPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeleteWhoisPrice = append(m.DeleteWhoisPrice, types.Coin{})
+			if err := m.DeleteWhoisPrice[len(m.DeleteWhoisPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewWhoisPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewWhoisPrice = append(m.RenewWhoisPrice, types.Coin{})
+			if err := m.RenewWhoisPrice[len(m.RenewWhoisPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPeriod", wireType)
+			}
+			m.RegistrationPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryGracePeriod", wireType)
+			}
+			m.ExpiryGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryGracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpirationsPerBlock", wireType)
+			}
+			m.MaxExpirationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpirationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TldPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TldPrices = append(m.TldPrices, TldPrice{})
+			if err := m.TldPrices[len(m.TldPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LengthPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LengthPrices = append(m.LengthPrices, LengthPrice{})
+			if err := m.LengthPrices[len(m.LengthPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TldPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TldPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TldPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tld = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LengthPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LengthPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LengthPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLength", wireType)
+			}
+			m.MaxLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
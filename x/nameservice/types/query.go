@@ -3,4 +3,10 @@ package types
 const (
 	QueryGetWhois  = "get-whois"
 	QueryListWhois = "list-whois"
+
+	QueryResolveName    = "resolve"
+	QueryNamesByAddress = "names-by-address"
+	QueryWhoisByOwner   = "names-owned"
+
+	QueryParams = "params"
 )
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	validator "github.com/asaskevich/govalidator"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetTld defines the type for a SetTldProposal
+	ProposalTypeSetTld = "SetTld"
+)
+
+// Assert SetTldProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &SetTldProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetTld)
+	govtypes.RegisterProposalTypeCodec(&SetTldProposal{}, "nameservice/SetTldProposal")
+}
+
+// DefaultTlds returns the top level domains a new chain starts with
+func DefaultTlds() []*Tld {
+	return []*Tld{
+		{Name: "wallet", Enabled: true, Description: "names of user wallets"},
+		{Name: "contract", Enabled: true, Description: "names of contracts"},
+		{Name: "validator", Enabled: true, Description: "names of validators"},
+	}
+}
+
+// Validate performs basic validation of a top level domain
+func (tld Tld) Validate() error {
+	if len(tld.Name) == 0 {
+		return fmt.Errorf("tld name cannot be empty")
+	}
+
+	// a tld is a single DNS label
+	if strings.Contains(tld.Name, ".") || !validator.IsDNSName(tld.Name) {
+		return fmt.Errorf("tld name %s is not a valid DNS label", tld.Name)
+	}
+
+	// the tld of a name is looked up in the canonical form of the name
+	if tld.Name != CanonicalName(tld.Name) {
+		return fmt.Errorf("tld name %s is not in canonical form, expected %s", tld.Name, CanonicalName(tld.Name))
+	}
+
+	return nil
+}
+
+// NewSetTldProposal creates a new set tld proposal
+func NewSetTldProposal(title, description string, tld Tld) *SetTldProposal {
+	return &SetTldProposal{title, description, tld}
+}
+
+// GetTitle returns the title of a set tld proposal
+func (p *SetTldProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a set tld proposal
+func (p *SetTldProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a set tld proposal
+func (p *SetTldProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set tld proposal
+func (p *SetTldProposal) ProposalType() string { return ProposalTypeSetTld }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetTldProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Tld.Validate()
+}
+
+// String implements the Stringer interface
+func (p SetTldProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set TLD Proposal:
+  Title:           %s
+  Description:     %s
+  TLD:             %s
+  Enabled:         %t
+  TLD Description: %s
+`, p.Title, p.Description, p.Tld.Name, p.Tld.Enabled, p.Tld.Description))
+	return b.String()
+}
@@ -1,30 +1,76 @@
 package types
 
 import (
+	"fmt"
 	"regexp"
-	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+var (
+	// coinRegex splits a coin into its whole amount, its optional fraction and its denom
+	coinRegex = regexp.MustCompile(`^([[:digit:]]+)(?:\.([[:digit:]]+))?[[:space:]]*(.+)$`)
+	// exponentRegex matches a denom that is the exponent of an amount in scientific notation
+	exponentRegex = regexp.MustCompile(`^[eE][+-]?[[:digit:]]`)
+)
+
+// ParseCoins - convert a price (type string) in the form of <amount><denom>[,<amount><denom>...] to type sdk.Coins
+//
+// Amounts may be written as decimals as long as they are whole numbers, e.g. "1.0trycoin",
+// but not in scientific notation. Denoms are kept as written. Coins with a zero amount are
+// dropped, so an empty or zero price parses to empty coins.
+func ParseCoins(price string) (sdk.Coins, error) {
+	price = strings.TrimSpace(price)
+	if len(price) == 0 {
+		return sdk.Coins{}, nil
+	}
+
+	coins := make(sdk.Coins, 0, strings.Count(price, ",")+1)
+	for _, coinStr := range strings.Split(price, ",") {
+		coin, err := parseCoin(strings.TrimSpace(coinStr))
+		if err != nil {
+			return nil, sdkerrors.Wrap(ErrInvalidPrice, err.Error())
+		}
+		if coin.IsZero() {
+			continue
+		}
+		coins = append(coins, coin)
+	}
+
+	coins = coins.Sort()
+	if err := coins.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidPrice, err.Error())
+	}
+
+	return coins, nil
+}
+
+// parseCoin parses a single <amount><denom> coin
+func parseCoin(coinStr string) (sdk.Coin, error) {
+	matches := coinRegex.FindStringSubmatch(coinStr)
+	if matches == nil {
+		return sdk.Coin{}, fmt.Errorf("invalid coin expression: %q", coinStr)
+	}
+	amountStr, fraction, denom := matches[1], matches[2], matches[3]
+
+	if exponentRegex.MatchString(denom) {
+		return sdk.Coin{}, fmt.Errorf("amount of %q is in scientific notation", coinStr)
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if strings.Trim(fraction, "0") != "" {
+		return sdk.Coin{}, fmt.Errorf("amount of %q is not a whole number", coinStr)
+	}
+
+	// NewIntFromString refuses amounts that don't fit an sdk.Int instead of panicking
+	amount, ok := sdk.NewIntFromString(amountStr)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("amount of %q is out of range", coinStr)
+	}
 
-// CoinsFromString - convert price (type string) in the form of <amount><denom> to type sdk.Coins
-func CoinsFromString(price string) (sdk.Coins, error) {
-	re := regexp.MustCompile("[[:digit:]]+")
-	// get location of price amount
-	loc := re.FindIndex([]byte(price))
-	// get price amount as string
-	strAmount := re.FindString(price)
-	// get price demon from location of amount to end of price string 
-	denom := price[loc[1]:len(price)]
-	// convert to lower case
-	denom = strings.ToLower(denom)
-	// convert price amount from string to int64
-	amount, err := strconv.ParseInt(strAmount, 10, 64)
-	if err != nil {
-		return nil, err
-	}
-	// return Coins type
-	return sdk.NewCoins(sdk.NewInt64Coin(denom, amount)), nil
+	return sdk.Coin{Denom: denom, Amount: amount}, nil
 }
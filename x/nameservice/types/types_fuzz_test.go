@@ -0,0 +1,40 @@
+//go:build go1.18
+// +build go1.18
+
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzParseCoins checks that ParseCoins either fails with ErrInvalidPrice or returns valid
+// coins. Fuzzing needs go 1.18, the build tag keeps the package testable with go 1.15.
+func FuzzParseCoins(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		"5trycoin",
+		"5trycoin,3atom",
+		"1.0trycoin",
+		"1e5trycoin",
+		"0trycoin",
+		"5ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		strings.Repeat("9", 77) + "trycoin",
+		strings.Repeat("9", 80) + ".5trycoin",
+		",,",
+		".5trycoin",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, price string) {
+		coins, err := ParseCoins(price)
+		if err != nil {
+			require.ErrorIs(t, err, ErrInvalidPrice)
+			return
+		}
+		require.NoError(t, coins.Validate())
+	})
+}
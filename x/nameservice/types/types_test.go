@@ -0,0 +1,65 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCoins(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		price string
+		coins sdk.Coins
+		err   bool
+	}{
+		{desc: "Empty", price: "", coins: sdk.Coins{}},
+		{desc: "Blank", price: "  ", coins: sdk.Coins{}},
+		{desc: "Single", price: "5trycoin", coins: sdk.NewCoins(sdk.NewInt64Coin("trycoin", 5))},
+		{desc: "Spaces", price: " 5 trycoin ", coins: sdk.NewCoins(sdk.NewInt64Coin("trycoin", 5))},
+		{desc: "Multiple", price: "5trycoin,3atom", coins: sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin("trycoin", 5))},
+		{desc: "WholeDecimal", price: "5.000trycoin", coins: sdk.NewCoins(sdk.NewInt64Coin("trycoin", 5))},
+		{desc: "Zero", price: "0trycoin", coins: sdk.Coins{}},
+		{desc: "ZeroDropped", price: "0atom,5trycoin", coins: sdk.NewCoins(sdk.NewInt64Coin("trycoin", 5))},
+		{
+			desc:  "IBCDenom",
+			price: "5ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+			coins: sdk.Coins{sdk.NewInt64Coin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 5)},
+		},
+		{
+			desc:  "LargeAmount",
+			price: strings.Repeat("9", 76) + "trycoin",
+			coins: sdk.Coins{sdk.NewCoin("trycoin", mustInt(t, strings.Repeat("9", 76)))},
+		},
+		{desc: "NoDigits", price: "trycoin", err: true},
+		{desc: "NoDenom", price: "5", err: true},
+		{desc: "ShortDenom", price: "5tc", err: true},
+		{desc: "Fraction", price: "1.5trycoin", err: true},
+		{desc: "Negative", price: "-5trycoin", err: true},
+		{desc: "Exponent", price: "1e5trycoin", err: true},
+		{desc: "NegativeExponent", price: "1E-5trycoin", err: true},
+		{desc: "Duplicate", price: "5trycoin,3trycoin", err: true},
+		{desc: "EmptyCoin", price: "5trycoin,", err: true},
+		{desc: "Overflow", price: strings.Repeat("9", 77) + "trycoin", err: true},
+		{desc: "HugeOverflow", price: strings.Repeat("9", 200) + ".0trycoin", err: true},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			coins, err := ParseCoins(tc.price)
+			if tc.err {
+				require.ErrorIs(t, err, ErrInvalidPrice)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.coins.String(), coins.String())
+		})
+	}
+}
+
+func mustInt(t *testing.T, s string) sdk.Int {
+	i, ok := sdk.NewIntFromString(s)
+	require.True(t, ok)
+	return i
+}
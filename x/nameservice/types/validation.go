@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	validator "github.com/asaskevich/govalidator"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Length limits of the message fields
+const (
+	// MaxNameLength is the longest name a DNS name can be
+	MaxNameLength = 253
+	// MaxIdLength is the number of digits of the largest uint64
+	MaxIdLength = 20
+	// MaxPriceLength bounds the price expressions parsed for a message
+	MaxPriceLength = 256
+)
+
+// ValidateName checks that name is a DNS name under a top level domain and returns that domain
+func ValidateName(name string) (string, error) {
+	if len(name) == 0 {
+		return "", fmt.Errorf("name cannot be empty")
+	}
+
+	if len(name) > MaxNameLength {
+		return "", fmt.Errorf("name is longer than %d characters", MaxNameLength)
+	}
+
+	// names are registered in their canonical form only
+	if name != CanonicalName(name) {
+		return "", fmt.Errorf("name %s is not in canonical form, expected %s", name, CanonicalName(name))
+	}
+
+	// name is invalid if it does not conform to a DNS name
+	if !validator.IsDNSName(name) {
+		return "", fmt.Errorf("name %s is not a valid DNS name", name)
+	}
+
+	// the part after the last period is the top level domain
+	nameParts := strings.Split(name, ".")
+	if len(nameParts) < 2 {
+		return "", fmt.Errorf("name %s has no top level domain", name)
+	}
+
+	return nameParts[len(nameParts)-1], nil
+}
+
+// CanonicalName returns the canonical form of a name, names are registered lowercase
+func CanonicalName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// IsWhoisId tells whether key is the id of a whois rather than a name. Ids are
+// decimal numbers while names always hold a dot, so the two can't be mistaken.
+func IsWhoisId(key string) bool {
+	return ValidateId(key) == nil
+}
+
+// ValidateAddress checks that address is a valid bech32 account address
+func ValidateAddress(address string) error {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+
+	return sdk.VerifyAddressFormat(addr)
+}
+
+// ValidateId checks that id is the decimal number of a whois
+func ValidateId(id string) error {
+	if len(id) == 0 {
+		return fmt.Errorf("id cannot be empty")
+	}
+
+	if len(id) > MaxIdLength {
+		return fmt.Errorf("id is longer than %d characters", MaxIdLength)
+	}
+
+	if _, err := strconv.ParseUint(id, 10, 64); err != nil {
+		return fmt.Errorf("id %s is not a whois id", id)
+	}
+
+	return nil
+}
+
+// ValidatePrice checks that price is a positive coin expression the handlers can charge
+func ValidatePrice(price string) error {
+	if len(price) > MaxPriceLength {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "price is longer than %d characters", MaxPriceLength)
+	}
+
+	coins, err := ParseCoins(price)
+	if err != nil {
+		return err
+	}
+
+	// A name without a price could be bought for nothing
+	if coins.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "price %q must be positive", price)
+	}
+
+	return nil
+}
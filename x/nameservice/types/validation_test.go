@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePrice(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		price string
+		valid bool
+	}{
+		{desc: "Positive", price: "5trycoin", valid: true},
+		{desc: "Multiple", price: "5trycoin,1atom", valid: true},
+		{desc: "Empty", price: ""},
+		{desc: "Zero", price: "0trycoin"},
+		{desc: "AllZero", price: "0trycoin,0atom"},
+		{desc: "Invalid", price: "trycoin"},
+		{desc: "TooLong", price: strings.Repeat("1", MaxPriceLength) + "trycoin"},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := ValidatePrice(tc.price)
+			if tc.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidPrice)
+		})
+	}
+}
+
+func TestMsgValidateBasicRejectsFreeNames(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+	for _, price := range []string{"", "0trycoin"} {
+		require.ErrorIs(t, NewMsgCreateWhois(creator, "alpha.wallet", creator, price).ValidateBasic(), ErrInvalidPrice)
+		require.ErrorIs(t, NewMsgUpdateWhois(creator, "alpha.wallet", "alpha.wallet", creator, price, nil, 0).ValidateBasic(), ErrInvalidPrice)
+		require.ErrorIs(t, NewMsgBuyWhois(creator, "alpha.wallet", price).ValidateBasic(), ErrInvalidPrice)
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Validate checks a stored whois against the rules enforced when it was registered,
+// the top level domain of its name must be one of tlds
+func (whois Whois) Validate(tlds map[string]bool) error {
+	tld, err := ValidateName(whois.Name)
+	if err != nil {
+		return err
+	}
+	if !tlds[tld] {
+		return fmt.Errorf("unknown tld %s", tld)
+	}
+
+	if err := ValidateAddress(whois.Creator); err != nil {
+		return fmt.Errorf("invalid creator %s: %w", whois.Creator, err)
+	}
+
+	if err := ValidateAddress(whois.Address); err != nil {
+		return fmt.Errorf("invalid address %s: %w", whois.Address, err)
+	}
+
+	// Unlike ValidatePrice an empty or zero price is accepted: older versions registered
+	// names without a price and an exported chain has to import again. Such names can't be
+	// bought, BuyWhois refuses to sell a name for nothing.
+	if _, err := ParseCoins(whois.Price); err != nil {
+		return fmt.Errorf("invalid price %s: %s", whois.Price, err)
+	}
+
+	if whois.Expires < 0 {
+		return fmt.Errorf("expiry height cannot be negative: %d", whois.Expires)
+	}
+
+	return nil
+}
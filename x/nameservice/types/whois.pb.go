@@ -24,11 +24,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Whois struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	Price   string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id       string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Address  string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Price    string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	Expires  int64  `protobuf:"varint,6,opt,name=expires,proto3" json:"expires,omitempty"`
+	ForSale  bool   `protobuf:"varint,7,opt,name=forSale,proto3" json:"forSale,omitempty"`
+	Revision uint64 `protobuf:"varint,8,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
 func (m *Whois) Reset()         { *m = Whois{} }
@@ -99,94 +102,47 @@ func (m *Whois) GetPrice() string {
 	return ""
 }
 
-type MsgCreateWhois struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Price   string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
-}
-
-func (m *MsgCreateWhois) Reset()         { *m = MsgCreateWhois{} }
-func (m *MsgCreateWhois) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateWhois) ProtoMessage()    {}
-func (*MsgCreateWhois) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffb1e5b15fe01e48, []int{1}
-}
-func (m *MsgCreateWhois) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MsgCreateWhois) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgCreateWhois.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *MsgCreateWhois) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateWhois.Merge(m, src)
-}
-func (m *MsgCreateWhois) XXX_Size() int {
-	return m.Size()
-}
-func (m *MsgCreateWhois) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateWhois.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgCreateWhois proto.InternalMessageInfo
-
-func (m *MsgCreateWhois) GetCreator() string {
+func (m *Whois) GetExpires() int64 {
 	if m != nil {
-		return m.Creator
+		return m.Expires
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgCreateWhois) GetName() string {
+func (m *Whois) GetForSale() bool {
 	if m != nil {
-		return m.Name
+		return m.ForSale
 	}
-	return ""
-}
-
-func (m *MsgCreateWhois) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
+	return false
 }
 
-func (m *MsgCreateWhois) GetPrice() string {
+func (m *Whois) GetRevision() uint64 {
 	if m != nil {
-		return m.Price
+		return m.Revision
 	}
-	return ""
+	return 0
 }
 
-type MsgUpdateWhois struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	Price   string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+// WhoisTransfer is an ownership transfer offered by the owner and awaiting the recipient
+type WhoisTransfer struct {
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner     string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgUpdateWhois) Reset()         { *m = MsgUpdateWhois{} }
-func (m *MsgUpdateWhois) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateWhois) ProtoMessage()    {}
-func (*MsgUpdateWhois) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffb1e5b15fe01e48, []int{2}
+func (m *WhoisTransfer) Reset()         { *m = WhoisTransfer{} }
+func (m *WhoisTransfer) String() string { return proto.CompactTextString(m) }
+func (*WhoisTransfer) ProtoMessage()    {}
+func (*WhoisTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb1e5b15fe01e48, []int{1}
 }
-func (m *MsgUpdateWhois) XXX_Unmarshal(b []byte) error {
+func (m *WhoisTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateWhois) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WhoisTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateWhois.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WhoisTransfer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -196,70 +152,64 @@ func (m *MsgUpdateWhois) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateWhois) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateWhois.Merge(m, src)
+func (m *WhoisTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhoisTransfer.Merge(m, src)
 }
-func (m *MsgUpdateWhois) XXX_Size() int {
+func (m *WhoisTransfer) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateWhois) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateWhois.DiscardUnknown(m)
+func (m *WhoisTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhoisTransfer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateWhois proto.InternalMessageInfo
+var xxx_messageInfo_WhoisTransfer proto.InternalMessageInfo
 
-func (m *MsgUpdateWhois) GetCreator() string {
-	if m != nil {
-		return m.Creator
-	}
-	return ""
-}
-
-func (m *MsgUpdateWhois) GetId() string {
+func (m *WhoisTransfer) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *MsgUpdateWhois) GetName() string {
+func (m *WhoisTransfer) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgUpdateWhois) GetAddress() string {
+func (m *WhoisTransfer) GetOwner() string {
 	if m != nil {
-		return m.Address
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *MsgUpdateWhois) GetPrice() string {
+func (m *WhoisTransfer) GetRecipient() string {
 	if m != nil {
-		return m.Price
+		return m.Recipient
 	}
 	return ""
 }
 
-type MsgDeleteWhois struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+// PrimaryName is the name an address chose to be reverse resolved to
+type PrimaryName struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *MsgDeleteWhois) Reset()         { *m = MsgDeleteWhois{} }
-func (m *MsgDeleteWhois) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteWhois) ProtoMessage()    {}
-func (*MsgDeleteWhois) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffb1e5b15fe01e48, []int{3}
+func (m *PrimaryName) Reset()         { *m = PrimaryName{} }
+func (m *PrimaryName) String() string { return proto.CompactTextString(m) }
+func (*PrimaryName) ProtoMessage()    {}
+func (*PrimaryName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb1e5b15fe01e48, []int{2}
 }
-func (m *MsgDeleteWhois) XXX_Unmarshal(b []byte) error {
+func (m *PrimaryName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteWhois) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PrimaryName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteWhois.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PrimaryName.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -269,60 +219,63 @@ func (m *MsgDeleteWhois) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteWhois) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteWhois.Merge(m, src)
+func (m *PrimaryName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrimaryName.Merge(m, src)
 }
-func (m *MsgDeleteWhois) XXX_Size() int {
+func (m *PrimaryName) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteWhois) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteWhois.DiscardUnknown(m)
+func (m *PrimaryName) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrimaryName.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteWhois proto.InternalMessageInfo
+var xxx_messageInfo_PrimaryName proto.InternalMessageInfo
 
-func (m *MsgDeleteWhois) GetCreator() string {
+func (m *PrimaryName) GetAddress() string {
 	if m != nil {
-		return m.Creator
+		return m.Address
 	}
 	return ""
 }
 
-func (m *MsgDeleteWhois) GetId() string {
+func (m *PrimaryName) GetName() string {
 	if m != nil {
-		return m.Id
+		return m.Name
 	}
 	return ""
 }
 
 func init() {
 	proto.RegisterType((*Whois)(nil), "enqack.nameservice.nameservice.Whois")
-	proto.RegisterType((*MsgCreateWhois)(nil), "enqack.nameservice.nameservice.MsgCreateWhois")
-	proto.RegisterType((*MsgUpdateWhois)(nil), "enqack.nameservice.nameservice.MsgUpdateWhois")
-	proto.RegisterType((*MsgDeleteWhois)(nil), "enqack.nameservice.nameservice.MsgDeleteWhois")
+	proto.RegisterType((*WhoisTransfer)(nil), "enqack.nameservice.nameservice.WhoisTransfer")
+	proto.RegisterType((*PrimaryName)(nil), "enqack.nameservice.nameservice.PrimaryName")
 }
 
 func init() { proto.RegisterFile("nameservice/whois.proto", fileDescriptor_ffb1e5b15fe01e48) }
 
 var fileDescriptor_ffb1e5b15fe01e48 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcf, 0x4b, 0xcc, 0x4d,
-	0x2d, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x2f, 0xcf, 0xc8, 0xcf, 0x2c, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x4b, 0xcd, 0x2b, 0x4c, 0x4c, 0xce, 0xd6, 0x43, 0x92, 0x47, 0x66,
-	0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x95, 0xea, 0x83, 0x58, 0x10, 0x5d, 0x4a, 0xa5, 0x5c,
-	0xac, 0xe1, 0x20, 0x43, 0x84, 0x24, 0xb8, 0xd8, 0x93, 0x8b, 0x52, 0x13, 0x4b, 0xf2, 0x8b, 0x24,
-	0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x60, 0x5c, 0x21, 0x3e, 0x2e, 0xa6, 0xcc, 0x14, 0x09, 0x26,
-	0xb0, 0x20, 0x53, 0x66, 0x8a, 0x90, 0x10, 0x17, 0x0b, 0xc8, 0x5c, 0x09, 0x66, 0xb0, 0x08, 0x98,
-	0x0d, 0xd2, 0x9d, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x2c, 0xc1, 0x02, 0xd1, 0x0d, 0xe5, 0x0a,
-	0x89, 0x70, 0xb1, 0x16, 0x14, 0x65, 0x26, 0xa7, 0x4a, 0xb0, 0x82, 0xc5, 0x21, 0x1c, 0xa5, 0x3c,
-	0x2e, 0x3e, 0xdf, 0xe2, 0x74, 0x67, 0x90, 0x0d, 0xa9, 0x84, 0xec, 0x87, 0xd9, 0xc7, 0x84, 0xdd,
-	0x3e, 0x66, 0x1c, 0xf6, 0xb1, 0x20, 0xdb, 0x57, 0x07, 0xb6, 0x2f, 0xb4, 0x20, 0x85, 0x08, 0xfb,
-	0x68, 0xe1, 0x5f, 0x2b, 0xb0, 0xfd, 0x2e, 0xa9, 0x39, 0xa9, 0x24, 0xdb, 0xef, 0xe4, 0x7d, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x86, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x90, 0xd8, 0xd7, 0x47, 0x4e, 0x1d, 0x15, 0x28, 0xbc, 0x92, 0xca,
-	0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xb4, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x89,
-	0xe3, 0x4b, 0x47, 0x02, 0x00, 0x00,
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x4d, 0x4b, 0xfb, 0x40,
+	0x10, 0xc6, 0xbb, 0x69, 0xd3, 0x97, 0xfd, 0xf3, 0xf7, 0xb0, 0x14, 0x5c, 0x8a, 0x2c, 0xa1, 0xa7,
+	0x9c, 0x1a, 0xc4, 0xa3, 0x37, 0xaf, 0x82, 0x48, 0x14, 0x04, 0x6f, 0xdb, 0x64, 0x9a, 0x2e, 0xda,
+	0x6c, 0x9c, 0x8d, 0x7d, 0xf9, 0x16, 0x7e, 0x29, 0xc1, 0x63, 0x8f, 0x1e, 0xa5, 0xfd, 0x22, 0x92,
+	0x4d, 0xa2, 0xa9, 0x7a, 0x9b, 0xdf, 0xb3, 0x33, 0xb3, 0xf3, 0xf0, 0xd0, 0xe3, 0x54, 0x2e, 0xc0,
+	0x00, 0x2e, 0x55, 0x04, 0xc1, 0x6a, 0xae, 0x95, 0x99, 0x64, 0xa8, 0x73, 0xcd, 0x04, 0xa4, 0x4f,
+	0x32, 0x7a, 0x98, 0x34, 0xde, 0x9b, 0xf5, 0x68, 0x98, 0xe8, 0x44, 0xdb, 0xd6, 0xa0, 0xa8, 0xca,
+	0xa9, 0xf1, 0x2b, 0xa1, 0xee, 0x5d, 0xb1, 0x85, 0x71, 0xda, 0x8b, 0x10, 0x64, 0xae, 0x91, 0x13,
+	0x8f, 0xf8, 0x83, 0xb0, 0x46, 0x76, 0x44, 0x1d, 0x15, 0x73, 0xc7, 0x8a, 0x8e, 0x8a, 0x19, 0xa3,
+	0x9d, 0x62, 0x31, 0x6f, 0x5b, 0xc5, 0xd6, 0xc5, 0xb4, 0x8c, 0x63, 0x04, 0x63, 0x78, 0xa7, 0x9c,
+	0xae, 0x90, 0x0d, 0xa9, 0x9b, 0xa1, 0x8a, 0x80, 0xbb, 0x56, 0x2f, 0xa1, 0xe8, 0x87, 0x75, 0xa6,
+	0x10, 0x0c, 0xef, 0x7a, 0xc4, 0x6f, 0x87, 0x35, 0x16, 0x2f, 0x33, 0x8d, 0x37, 0xf2, 0x11, 0x78,
+	0xcf, 0x23, 0x7e, 0x3f, 0xac, 0x91, 0x8d, 0x68, 0x1f, 0x61, 0xa9, 0x8c, 0xd2, 0x29, 0xef, 0x7b,
+	0xc4, 0xef, 0x84, 0x5f, 0x3c, 0x4e, 0xe8, 0x7f, 0x6b, 0xe3, 0x16, 0x65, 0x6a, 0x66, 0x50, 0x1f,
+	0x4d, 0x7e, 0x1d, 0xed, 0x34, 0x8e, 0x1e, 0x52, 0x57, 0xaf, 0x52, 0xc0, 0xca, 0x49, 0x09, 0xec,
+	0x84, 0x0e, 0x10, 0x22, 0x95, 0x29, 0x48, 0xf3, 0xca, 0xcc, 0xb7, 0x30, 0x3e, 0xa7, 0xff, 0xae,
+	0x51, 0x2d, 0x24, 0x6e, 0xae, 0x7e, 0xf8, 0x26, 0x87, 0xbe, 0xff, 0xf8, 0xf0, 0xe2, 0xf2, 0x6d,
+	0x27, 0xc8, 0x76, 0x27, 0xc8, 0xc7, 0x4e, 0x90, 0x97, 0xbd, 0x68, 0x6d, 0xf7, 0xa2, 0xf5, 0xbe,
+	0x17, 0xad, 0xfb, 0xd3, 0x44, 0xe5, 0xf3, 0xe7, 0xe9, 0x24, 0xd2, 0x8b, 0xa0, 0x0c, 0x32, 0x68,
+	0x06, 0xbd, 0x3e, 0xa0, 0x7c, 0x93, 0x81, 0x99, 0x76, 0x6d, 0x82, 0x67, 0x9f, 0x03, 0x00, 0x53,
+	0x7b, 0xfa, 0x82, 0x12, 0x02, 0x00, 0x00,
 }
 
 func (m *Whois) Marshal() (dAtA []byte, err error) {
@@ -345,6 +298,26 @@ func (m *Whois) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintWhois(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ForSale {
+		i--
+		if m.ForSale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Expires != 0 {
+		i = encodeVarintWhois(dAtA, i, uint64(m.Expires))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Price) > 0 {
 		i -= len(m.Price)
 		copy(dAtA[i:], m.Price)
@@ -383,7 +356,7 @@ func (m *Whois) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateWhois) Marshal() (dAtA []byte, err error) {
+func (m *WhoisTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -393,27 +366,27 @@ func (m *MsgCreateWhois) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateWhois) MarshalTo(dAtA []byte) (int, error) {
+func (m *WhoisTransfer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateWhois) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WhoisTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintWhois(dAtA, i, uint64(len(m.Price)))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintWhois(dAtA, i, uint64(len(m.Recipient)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintWhois(dAtA, i, uint64(len(m.Address)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintWhois(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -424,75 +397,17 @@ func (m *MsgCreateWhois) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintWhois(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateWhois) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateWhois) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateWhois) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintWhois(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintWhois(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintWhois(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
 		i = encodeVarintWhois(dAtA, i, uint64(len(m.Id)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintWhois(dAtA, i, uint64(len(m.Creator)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteWhois) Marshal() (dAtA []byte, err error) {
+func (m *PrimaryName) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -502,27 +417,27 @@ func (m *MsgDeleteWhois) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteWhois) MarshalTo(dAtA []byte) (int, error) {
+func (m *PrimaryName) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteWhois) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PrimaryName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintWhois(dAtA, i, uint64(len(m.Id)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWhois(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintWhois(dAtA, i, uint64(len(m.Creator)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintWhois(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -566,44 +481,24 @@ func (m *Whois) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWhois(uint64(l))
 	}
-	return n
-}
-
-func (m *MsgCreateWhois) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovWhois(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWhois(uint64(l))
+	if m.Expires != 0 {
+		n += 1 + sovWhois(uint64(m.Expires))
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovWhois(uint64(l))
+	if m.ForSale {
+		n += 2
 	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovWhois(uint64(l))
+	if m.Revision != 0 {
+		n += 1 + sovWhois(uint64(m.Revision))
 	}
 	return n
 }
 
-func (m *MsgUpdateWhois) Size() (n int) {
+func (m *WhoisTransfer) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovWhois(uint64(l))
-	}
 	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovWhois(uint64(l))
@@ -612,28 +507,28 @@ func (m *MsgUpdateWhois) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWhois(uint64(l))
 	}
-	l = len(m.Address)
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovWhois(uint64(l))
 	}
-	l = len(m.Price)
+	l = len(m.Recipient)
 	if l > 0 {
 		n += 1 + l + sovWhois(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgDeleteWhois) Size() (n int) {
+func (m *PrimaryName) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Creator)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovWhois(uint64(l))
 	}
-	l = len(m.Id)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovWhois(uint64(l))
 	}
@@ -835,93 +730,11 @@ func (m *Whois) Unmarshal(dAtA []byte) error {
 			}
 			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipWhois(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthWhois
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateWhois) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWhois
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateWhois: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateWhois: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWhois
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWhois
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWhois
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
 			}
-			var stringLen uint64
+			m.Expires = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWhois
@@ -931,29 +744,16 @@ func (m *MsgCreateWhois) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Expires |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWhois
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWhois
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForSale", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWhois
@@ -963,29 +763,17 @@ func (m *MsgCreateWhois) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWhois
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWhois
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			m.ForSale = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var stringLen uint64
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWhois
@@ -995,24 +783,11 @@ func (m *MsgCreateWhois) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Revision |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWhois
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWhois
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Price = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWhois(dAtA[iNdEx:])
@@ -1034,7 +809,7 @@ func (m *MsgCreateWhois) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateWhois) Unmarshal(dAtA []byte) error {
+func (m *WhoisTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1057,45 +832,13 @@ func (m *MsgUpdateWhois) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateWhois: wiretype end group for non-group")
+			return fmt.Errorf("proto: WhoisTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateWhois: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WhoisTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWhois
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWhois
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWhois
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
@@ -1127,7 +870,7 @@ func (m *MsgUpdateWhois) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -1159,9 +902,9 @@ func (m *MsgUpdateWhois) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1189,11 +932,11 @@ func (m *MsgUpdateWhois) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1221,7 +964,7 @@ func (m *MsgUpdateWhois) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1244,7 +987,7 @@ func (m *MsgUpdateWhois) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteWhois) Unmarshal(dAtA []byte) error {
+func (m *PrimaryName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1267,15 +1010,15 @@ func (m *MsgDeleteWhois) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteWhois: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrimaryName: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteWhois: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrimaryName: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1303,11 +1046,11 @@ func (m *MsgDeleteWhois) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1335,7 +1078,7 @@ func (m *MsgDeleteWhois) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex